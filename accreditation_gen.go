@@ -0,0 +1,108 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type AccreditationID int
+
+func (pID AccreditationID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type AccreditationService interface {
+	List(context.Context, *AccreditationQueryRequest) (*Accreditations, *PaginationResponse, error)
+	FindByID(context.Context, AccreditationID) (*Accreditation, error)
+	Create(context.Context, AccreditationAttributes) (*Accreditation, error)
+	Update(context.Context, AccreditationID, AccreditationAttributes) error
+	DeleteByID(context.Context, AccreditationID) error
+}
+
+type AccreditationClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/accreditations/index.html
+func (a *AccreditationClient) List(ctx context.Context, req *AccreditationQueryRequest) (*Accreditations, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "accreditations", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleAccreditationsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/accreditations/show.html
+func (a *AccreditationClient) FindByID(ctx context.Context, id AccreditationID) (*Accreditation, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("accreditations/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleAccreditationResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/accreditations/create.html
+func (a *AccreditationClient) Create(ctx context.Context, attrs AccreditationAttributes) (*Accreditation, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, "accreditations", "", nil, map[string]AccreditationAttributes{"accreditation": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleAccreditationResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/accreditations/update.html
+func (a *AccreditationClient) Update(ctx context.Context, id AccreditationID, attrs AccreditationAttributes) error {
+	res, err := a.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("accreditations/%s", id.String()), "", nil, map[string]AccreditationAttributes{"accreditation": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/accreditations/destroy.html
+func (a *AccreditationClient) DeleteByID(ctx context.Context, id AccreditationID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("accreditations/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+func handleAccreditationResponse(res *http.Response) (*Accreditation, error) {
+	var response Accreditation
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleAccreditationsPaginatedResponse(res *http.Response) (*Accreditations, *PaginationResponse, error) {
+	var response Accreditations
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
@@ -0,0 +1,42 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import "encoding/json"
+
+type Accreditations []Accreditation
+
+type Accreditation struct {
+	ID         int    `json:"id"`
+	CursusID   int    `json:"cursus_id"`
+	Difficulty int    `json:"difficulty,omitempty"`
+	Name       string `json:"name"`
+	UserID     int    `json:"user_id"`
+	Validated  bool   `json:"validated"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *Accreditation) UnmarshalJSON(data []byte) error {
+	type accreditation Accreditation
+
+	extra, err := unmarshalExtra(data, (*accreditation)(a))
+	a.Extra = extra
+
+	return err
+}
+
+func (a Accreditation) MarshalJSON() ([]byte, error) {
+	type accreditation Accreditation
+
+	return marshalExtra(accreditation(a), a.Extra)
+}
+
+type AccreditationQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
+
+// AccreditationAttributes are the attributes of the create and update requests, keyed by their API name.
+type AccreditationAttributes map[string]interface{}
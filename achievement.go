@@ -2,7 +2,6 @@ package fortytwo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -32,7 +31,7 @@ type AchievementClient struct {
 
 // Get https://api.intra.42.fr/apidoc/2.0/achievements/show.html
 func (a *AchievementClient) List(ctx context.Context, req *AchievementQueryRequest) (*Achievements, *PaginationResponse, error) {
-	res, err := a.apiClient.request(ctx, http.MethodGet, "achievements", "", req.Pagination.ToQuery(), nil)
+	res, err := a.apiClient.request(ctx, http.MethodGet, "achievements", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,7 +104,7 @@ func (a *AchievementClient) DeleteByID(ctx context.Context, id AchievementID) (*
 func handleAchievementResponse(res *http.Response) (*Achievement, error) {
 	var response Achievement
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -115,7 +114,7 @@ func handleAchievementResponse(res *http.Response) (*Achievement, error) {
 func handleAchievementsResponse(res *http.Response) (*Achievements, error) {
 	var response Achievements
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -125,7 +124,7 @@ func handleAchievementsResponse(res *http.Response) (*Achievements, error) {
 func handleAchievementsPaginatedResponse(res *http.Response) (*Achievements, *PaginationResponse, error) {
 	var response Achievements
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, nil, err
 	}
 
@@ -1,5 +1,7 @@
 package fortytwo
 
+import "encoding/json"
+
 type Achievements []Achievement
 
 type Achievement struct {
@@ -17,8 +19,27 @@ type Achievement struct {
 
 	Parent *Achievement `json:"parent,omitempty"`
 	Title  *Title       `json:"title,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *Achievement) UnmarshalJSON(data []byte) error {
+	type achievement Achievement
+
+	extra, err := unmarshalExtra(data, (*achievement)(a))
+	a.Extra = extra
+
+	return err
+}
+
+func (a Achievement) MarshalJSON() ([]byte, error) {
+	type achievement Achievement
+
+	return marshalExtra(achievement(a), a.Extra)
 }
 
 type AchievementQueryRequest struct {
 	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
 }
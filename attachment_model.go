@@ -0,0 +1,40 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Attachments []Attachment
+
+// Attachment is a pdf, a video or a link of a project.
+type Attachment struct {
+	ID        int        `json:"id"`
+	BaseID    int        `json:"base_id"`
+	Name      string     `json:"name"`
+	Slug      string     `json:"slug"`
+	Type      string     `json:"type"`
+	Url       *string    `json:"url"`
+	ThumbUrl  *string    `json:"thumb_url"`
+	PageCount int        `json:"page_count,omitempty"`
+	Language  *Language  `json:"language,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *Attachment) UnmarshalJSON(data []byte) error {
+	type attachment Attachment
+
+	extra, err := unmarshalExtra(data, (*attachment)(a))
+	a.Extra = extra
+
+	return err
+}
+
+func (a Attachment) MarshalJSON() ([]byte, error) {
+	type attachment Attachment
+
+	return marshalExtra(attachment(a), a.Extra)
+}
@@ -0,0 +1,179 @@
+// Package auth obtains user tokens from a terminal.
+//
+// Login runs the OAuth authorization code flow with a loopback HTTP server as redirect URI,
+// and FileStore keeps the resulting tokens in one credential file per profile.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+const defaultTimeout = 5 * time.Minute
+
+// ErrStateMismatch is returned when the state of the callback differs from the one sent to the user.
+var ErrStateMismatch = errors.New("auth: state mismatch, the callback was not triggered by this login")
+
+// LoginOptions configures Login.
+type LoginOptions struct {
+	// OpenBrowser opens the authorization page in the default browser
+	OpenBrowser bool
+	// Prompt is called with the authorization page URL, e.g. to print it
+	Prompt func(authURL string)
+	// Timeout bounds the time left to the user to authorize the application, defaults to 5 minutes
+	Timeout time.Duration
+}
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// Login asks the user to authorize the application and exchanges the returned code for a token.
+//
+// The redirect URI of the client must be a loopback address such as http://127.0.0.1:4242/callback,
+// registered as is in the application settings: Login listens on it to capture the code.
+func Login(ctx context.Context, c *fortytwo.Client, opts LoginOptions) (*oauth2.Token, error) {
+	redirect, err := url.Parse(c.RedirectURL())
+	if err != nil {
+		return nil, fmt.Errorf("auth: invalid redirect URL: %w", err)
+	}
+
+	if redirect.Scheme != "http" || !isLoopback(redirect.Hostname()) {
+		return nil, fmt.Errorf("auth: redirect URL %q is not a loopback http address", c.RedirectURL())
+	}
+
+	state, err := newState()
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", redirect.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(chan callbackResult, 1)
+
+	path := redirect.Path
+	if path == "" {
+		path = "/"
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		res := handleCallback(r, state)
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Logged in, you can close this window and go back to your terminal.")
+		}
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+
+	defer func() {
+		_ = srv.Close()
+	}()
+
+	authURL := c.GetLink(ctx, state)
+
+	if opts.Prompt != nil {
+		opts.Prompt(authURL)
+	}
+
+	if opts.OpenBrowser {
+		// The URL has been handed to the prompt, failing to open a browser is not fatal
+		_ = openBrowser(authURL)
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if res.err != nil {
+			return nil, res.err
+		}
+
+		return c.GetToken(ctx, res.code)
+	}
+}
+
+func handleCallback(r *http.Request, state string) callbackResult {
+	q := r.URL.Query()
+
+	if errCode := q.Get("error"); errCode != "" {
+		return callbackResult{err: fmt.Errorf("auth: authorization denied: %s %s", errCode, q.Get("error_description"))}
+	}
+
+	if q.Get("state") != state {
+		return callbackResult{err: ErrStateMismatch}
+	}
+
+	code := q.Get("code")
+	if code == "" {
+		return callbackResult{err: errors.New("auth: callback without code")}
+	}
+
+	return callbackResult{code: code}
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+func newState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func openBrowser(u string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", u).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", u).Start()
+	default:
+		return exec.Command("xdg-open", u).Start()
+	}
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"golang.org/x/oauth2"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// DefaultProfile is the profile used when none is given.
+const DefaultProfile = "default"
+
+// ErrNotLoggedIn is returned when no token is stored for a profile.
+var ErrNotLoggedIn = errors.New("auth: not logged in")
+
+var profileRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// FileStore keeps the token of every profile in its own credential file, readable by the owner only.
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore returns a FileStore in dir, or in the ft/credentials directory of the user
+// configuration directory if dir is empty.
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		cfgDir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, err
+		}
+
+		dir = filepath.Join(cfgDir, "ft", "credentials")
+	}
+
+	return &FileStore{Dir: dir}, nil
+}
+
+// Load returns the token of a profile, ErrNotLoggedIn if there is none.
+func (s *FileStore) Load(profile string) (*oauth2.Token, error) {
+	path, err := s.path(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotLoggedIn
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var tok oauth2.Token
+	if err := json.Unmarshal(data, &tok); err != nil {
+		return nil, fmt.Errorf("auth: corrupted credential file %s: %w", path, err)
+	}
+
+	return &tok, nil
+}
+
+// Save writes the token of a profile.
+func (s *FileStore) Save(profile string, tok *oauth2.Token) error {
+	path, err := s.path(profile)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(tok, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Delete removes the token of a profile.
+func (s *FileStore) Delete(profile string) error {
+	path, err := s.path(profile)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *FileStore) path(profile string) (string, error) {
+	if !profileRegexp.MatchString(profile) {
+		return "", fmt.Errorf("auth: invalid profile name %q", profile)
+	}
+
+	return filepath.Join(s.Dir, profile+".json"), nil
+}
+
+// Token returns the stored token of a profile, refreshed through the client if it expired.
+// A refreshed token is written back to the store.
+func Token(ctx context.Context, c *fortytwo.Client, s *FileStore, profile string) (*oauth2.Token, error) {
+	tok, err := s.Load(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	fresh, err := c.TokenSource(ctx, tok).Token()
+	if err != nil {
+		return nil, fmt.Errorf("auth: refresh token of profile %s: %w", profile, err)
+	}
+
+	if fresh.AccessToken != tok.AccessToken {
+		if err := s.Save(profile, fresh); err != nil {
+			return nil, err
+		}
+	}
+
+	return fresh, nil
+}
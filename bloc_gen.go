@@ -0,0 +1,69 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type BlocID int
+
+func (pID BlocID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type BlocService interface {
+	List(context.Context, *BlocQueryRequest) (*Blocs, *PaginationResponse, error)
+	FindByID(context.Context, BlocID) (*Bloc, error)
+}
+
+type BlocClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/blocs/index.html
+func (b *BlocClient) List(ctx context.Context, req *BlocQueryRequest) (*Blocs, *PaginationResponse, error) {
+	res, err := b.apiClient.request(ctx, http.MethodGet, "blocs", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleBlocsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/blocs/show.html
+func (b *BlocClient) FindByID(ctx context.Context, id BlocID) (*Bloc, error) {
+	res, err := b.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("blocs/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleBlocResponse(res)
+}
+
+func handleBlocResponse(res *http.Response) (*Bloc, error) {
+	var response Bloc
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleBlocsPaginatedResponse(res *http.Response) (*Blocs, *PaginationResponse, error) {
+	var response Blocs
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
@@ -0,0 +1,43 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Blocs []Bloc
+
+type Bloc struct {
+	ID         int         `json:"id"`
+	CampusID   int         `json:"campus_id"`
+	Coalitions []Coalition `json:"coalitions"`
+	CreatedAt  time.Time   `json:"created_at"`
+	CursusID   int         `json:"cursus_id"`
+	SquadSize  int         `json:"squad_size"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (b *Bloc) UnmarshalJSON(data []byte) error {
+	type bloc Bloc
+
+	extra, err := unmarshalExtra(data, (*bloc)(b))
+	b.Extra = extra
+
+	return err
+}
+
+func (b Bloc) MarshalJSON() ([]byte, error) {
+	type bloc Bloc
+
+	return marshalExtra(bloc(b), b.Extra)
+}
+
+type BlocQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
@@ -0,0 +1,106 @@
+package fortytwo
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithCache keeps the successful GET responses made with the application token for ttl,
+// the oldest responses are evicted beyond maxEntries, 0 meaning no limit
+func WithCache(ttl time.Duration, maxEntries int) ClientOption {
+	return func(c *Client) {
+		if ttl <= 0 {
+			c.cache = nil
+
+			return
+		}
+
+		c.cache = &responseCache{
+			ttl:        ttl,
+			maxEntries: maxEntries,
+			entries:    map[string]*cacheEntry{},
+		}
+	}
+}
+
+type cacheEntry struct {
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// responseCache is an in-memory cache of API responses evicting the oldest entries first.
+type responseCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*cacheEntry
+	order      []string
+}
+
+// get returns a copy of the cached response for key, if it has not expired.
+func (rc *responseCache) get(key string) (*http.Response, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	e, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(e.expires) {
+		return nil, false
+	}
+
+	return &http.Response{
+		Status:     http.StatusText(e.status),
+		StatusCode: e.status,
+		Header:     e.header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(e.body)),
+	}, true
+}
+
+// put stores the response under key, its body is read and replaced by an in-memory copy.
+func (rc *responseCache) put(key string, res *http.Response) error {
+	body, err := io.ReadAll(res.Body)
+	closeBody(res.Body)
+
+	if err != nil {
+		return err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.entries[key]; !ok {
+		rc.order = append(rc.order, key)
+	}
+
+	rc.entries[key] = &cacheEntry{
+		status:  res.StatusCode,
+		header:  res.Header.Clone(),
+		body:    body,
+		expires: time.Now().Add(rc.ttl),
+	}
+
+	// Drop the expired entries at the front, then the oldest ones beyond the limit
+	now := time.Now()
+
+	for len(rc.order) > 0 {
+		oldest := rc.order[0]
+		if !now.After(rc.entries[oldest].expires) && (rc.maxEntries == 0 || len(rc.entries) <= rc.maxEntries) {
+			break
+		}
+
+		rc.order = rc.order[1:]
+		delete(rc.entries, oldest)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package fortytwo
 
 import (
+	"encoding/json"
 	"strconv"
 )
 
@@ -13,6 +14,40 @@ func (pID CampusID) String() string {
 type CampusSlice []*Campus
 
 type Campus struct {
-	ID   CampusID `json:"id"`
-	Name string   `json:"name"`
+	ID          CampusID  `json:"id"`
+	Name        string    `json:"name"`
+	TimeZone    string    `json:"time_zone"`
+	Language    *Language `json:"language,omitempty"`
+	UsersCount  int       `json:"users_count"`
+	VogsphereID *int      `json:"vogsphere_id"`
+	Country     string    `json:"country,omitempty"`
+	Address     string    `json:"address,omitempty"`
+	Zip         string    `json:"zip,omitempty"`
+	City        string    `json:"city,omitempty"`
+	Website     string    `json:"website,omitempty"`
+	Facebook    string    `json:"facebook,omitempty"`
+	Twitter     string    `json:"twitter,omitempty"`
+	Active      bool      `json:"active"`
+	Public      bool      `json:"public"`
+	// EmailExtension is the domain of the email addresses of the students, e.g. 42.fr
+	EmailExtension     string `json:"email_extension,omitempty"`
+	DefaultHiddenPhone bool   `json:"default_hidden_phone"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Campus) UnmarshalJSON(data []byte) error {
+	type campus Campus
+
+	extra, err := unmarshalExtra(data, (*campus)(c))
+	c.Extra = extra
+
+	return err
+}
+
+func (c Campus) MarshalJSON() ([]byte, error) {
+	type campus Campus
+
+	return marshalExtra(campus(c), c.Extra)
 }
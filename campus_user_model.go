@@ -0,0 +1,30 @@
+package fortytwo
+
+import "encoding/json"
+
+type CampusUsers []CampusUser
+
+type CampusUser struct {
+	ID        int      `json:"id"`
+	UserID    int      `json:"user_id"`
+	CampusID  CampusID `json:"campus_id"`
+	IsPrimary bool     `json:"is_primary"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CampusUser) UnmarshalJSON(data []byte) error {
+	type campusUser CampusUser
+
+	extra, err := unmarshalExtra(data, (*campusUser)(c))
+	c.Extra = extra
+
+	return err
+}
+
+func (c CampusUser) MarshalJSON() ([]byte, error) {
+	type campusUser CampusUser
+
+	return marshalExtra(campusUser(c), c.Extra)
+}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"reflect"
 	"strconv"
+	"sync"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -44,14 +45,32 @@ type Client struct {
 	ClientID     string
 	ClientSecret string
 
-	Achievement AchievementService
-	CursusUser  CursusUserService
-	Project     ProjectService
-	Cursus      CursusService
-	Title       TitleService
-	User        UserService
+	Achievement  AchievementService
+	CursusUser   CursusUserService
+	Location     LocationService
+	Project      ProjectService
+	ProjectsUser ProjectsUserService
+	ScaleTeam    ScaleTeamService
+	Slot         SlotService
+	Cursus       CursusService
+	Event        EventService
+	Exam         ExamService
+	Title        TitleService
+	User         UserService
+
+	generatedServices
 
 	maxRetries int
+
+	poolCredentials []Credentials
+	pool            *credentialPool
+	throttle        *throttle
+	cache           *responseCache
+	responseHook    ResponseHook
+	strict          bool
+
+	mu        sync.Mutex
+	rateLimit *RateLimit
 }
 
 func NewClient(ctx context.Context, ClientID, ClientSecret, RedirectURL string, Scope []string, opts ...ClientOption) (*Client, error) {
@@ -72,11 +91,13 @@ func NewClient(ctx context.Context, ClientID, ClientSecret, RedirectURL string,
 		return nil, err
 	}
 
+	defaultHTTPClient := cfg.Client(ctx)
+
 	c := &Client{
 		ClientID:     ClientID,
 		ClientSecret: ClientSecret,
 		redirectURL:  RedirectURL,
-		httpClient:   cfg.Client(ctx),
+		httpClient:   defaultHTTPClient,
 		baseURL:      uAPI,
 		apiVersion:   apiVersion,
 		maxRetries:   maxRetries,
@@ -85,15 +106,42 @@ func NewClient(ctx context.Context, ClientID, ClientSecret, RedirectURL string,
 
 	c.Achievement = &AchievementClient{apiClient: c}
 	c.CursusUser = &CursusUserClient{apiClient: c}
+	c.Location = &LocationClient{apiClient: c}
 	c.Project = &ProjectClient{apiClient: c}
+	c.ProjectsUser = &ProjectsUserClient{apiClient: c}
+	c.ScaleTeam = &ScaleTeamClient{apiClient: c}
+	c.Slot = &SlotClient{apiClient: c}
 	c.Cursus = &CursusClient{apiClient: c}
+	c.Event = &EventClient{apiClient: c}
+	c.Exam = &ExamClient{apiClient: c}
 	c.Title = &TitleClient{apiClient: c}
 	c.User = &UserClient{apiClient: c}
+	c.generatedServices.init(c)
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	if len(c.poolCredentials) > 0 {
+		creds := append([]Credentials{{ClientID: ClientID, ClientSecret: ClientSecret}}, c.poolCredentials...)
+
+		// The pool authenticates the requests itself, on top of the transport of a client given by WithHTTPClient
+		var base http.RoundTripper
+
+		httpClient := &http.Client{}
+		if c.httpClient != defaultHTTPClient {
+			*httpClient = *c.httpClient
+			base = c.httpClient.Transport
+		}
+
+		if c.pool, err = newCredentialPool(ctx, base, creds, cfg.Scopes); err != nil {
+			return nil, err
+		}
+
+		httpClient.Transport = c.pool
+		c.httpClient = httpClient
+	}
+
 	return c, nil
 }
 
@@ -111,6 +159,13 @@ func WithVersion(version string) ClientOption {
 	}
 }
 
+// WithBaseURL overrides the Intra42 API URL, e.g. to go through a proxy
+func WithBaseURL(u *url.URL) ClientOption {
+	return func(c *Client) {
+		c.baseURL = u
+	}
+}
+
 // WithRetry overrides the default number of max retry attempts on 429 errors
 func WithRetry(retries int) ClientOption {
 	return func(c *Client) {
@@ -158,11 +213,37 @@ func (c *Client) request(ctx context.Context, method, urlStr, token string, quer
 	req.Header.Add("Intra42-Version", c.fortyTwoVersion)
 	req.Header.Add("Content-Type", "application/json")
 
+	// Only the application responses are shared, user responses depend on the token
+	cacheable := c.cache != nil && method == http.MethodGet && token == ""
+	cacheKey := c.fortyTwoVersion + " " + u.String()
+
+	if cacheable {
+		if res, ok := c.cache.get(cacheKey); ok {
+			return c.markStrict(res), nil
+		}
+	}
+
 	failedAttempts := 0
 
 	var res *http.Response
 
 	for {
+		if c.throttle != nil {
+			if err := c.throttle.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		// The previous attempt consumed the body
+		if failedAttempts > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req.Body = body
+		}
+
 		if token != "" {
 			res, err = http.DefaultClient.Do(req.WithContext(ctx))
 		} else {
@@ -172,6 +253,10 @@ func (c *Client) request(ctx context.Context, method, urlStr, token string, quer
 			return nil, err
 		}
 
+		if token == "" {
+			c.setRateLimit(GetRateLimitInfo(res.Header))
+		}
+
 		if res.StatusCode != http.StatusTooManyRequests {
 			break
 		}
@@ -200,7 +285,8 @@ func (c *Client) request(ctx context.Context, method, urlStr, token string, quer
 		}
 	}
 
-	if res.StatusCode != http.StatusOK {
+	// Created and No Content answer the create, update and destroy actions
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
 		var apiErr Error
 
 		if err := json.NewDecoder(res.Body).Decode(&apiErr); err != nil {
@@ -211,26 +297,52 @@ func (c *Client) request(ctx context.Context, method, urlStr, token string, quer
 		return nil, &apiErr
 	}
 
-	return res, nil
+	if c.responseHook != nil {
+		if err := c.callResponseHook(req, res); err != nil {
+			return nil, err
+		}
+	}
+
+	if cacheable {
+		if err := c.cache.put(cacheKey, res); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.markStrict(res), nil
 }
 
-func (c *Client) GetLink(ctx context.Context, state string) string {
-	cfg := oauth2.Config{
-		ClientID:     c.ClientID,
-		ClientSecret: c.ClientSecret,
-		Scopes:       c.Scope,
-		RedirectURL:  c.redirectURL,
-		Endpoint: oauth2.Endpoint{
-			AuthURL:  apiAuth,
-			TokenURL: apiToken,
-		},
+// RateLimit returns the application budget reported by the last API response, summed over
+// every application when a credential pool is configured. It returns nil until a response has been received.
+func (c *Client) RateLimit() *RateLimit {
+	if c.pool != nil {
+		return c.pool.RateLimit()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.rateLimit == nil {
+		return nil
 	}
 
-	return cfg.AuthCodeURL(state)
+	rl := *c.rateLimit
+
+	return &rl
 }
 
-func (c *Client) GetToken(ctx context.Context, code string) (*oauth2.Token, error) {
-	cfg := oauth2.Config{
+func (c *Client) setRateLimit(rl *RateLimit) {
+	if rl == nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.rateLimit = rl
+	c.mu.Unlock()
+}
+
+func (c *Client) oauthConfig() *oauth2.Config {
+	return &oauth2.Config{
 		ClientID:     c.ClientID,
 		ClientSecret: c.ClientSecret,
 		Scopes:       c.Scope,
@@ -240,11 +352,27 @@ func (c *Client) GetToken(ctx context.Context, code string) (*oauth2.Token, erro
 			TokenURL: apiToken,
 		},
 	}
+}
 
-	token, err := cfg.Exchange(ctx, code)
+// RedirectURL returns the OAuth redirect URI the client was created with.
+func (c *Client) RedirectURL() string {
+	return c.redirectURL
+}
+
+func (c *Client) GetLink(ctx context.Context, state string) string {
+	return c.oauthConfig().AuthCodeURL(state)
+}
+
+func (c *Client) GetToken(ctx context.Context, code string) (*oauth2.Token, error) {
+	token, err := c.oauthConfig().Exchange(ctx, code)
 	if err != nil {
 		return nil, err
 	}
 
 	return token, nil
 }
+
+// TokenSource returns a token source refreshing the user token once it expires.
+func (c *Client) TokenSource(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+	return c.oauthConfig().TokenSource(ctx, token)
+}
@@ -0,0 +1,262 @@
+// Command fortytwo-coverage reports which endpoints of docs/openapi3.json the client supports.
+//
+// It finds the requests made by the service methods of the package, matches their method and
+// path with the operations of the specification and writes a coverage matrix per resource and
+// HTTP method, the unsupported endpoints, and the client methods whose endpoint is missing from
+// the specification. PUT and PATCH are the same update action of the API.
+//
+// Usage:
+//
+//	go run ./cmd/fortytwo-coverage -spec docs/openapi3.json -dir . [-format json] [-tags Users,Projects]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// methods are the columns of the matrix.
+var methods = []string{"GET", "POST", "PATCH", "PUT", "DELETE"}
+
+var paramPattern = regexp.MustCompile(`\{[^}]*\}`)
+
+// Endpoint is an operation of the specification.
+type Endpoint struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Clients are the methods of the client calling the endpoint
+	Clients []string `json:"clients,omitempty"`
+}
+
+// Count is the number of supported endpoints out of the total.
+type Count struct {
+	Supported int `json:"supported"`
+	Total     int `json:"total"`
+}
+
+func (c *Count) add(supported bool) {
+	c.Total++
+
+	if supported {
+		c.Supported++
+	}
+}
+
+// Resource is the coverage of the endpoints of an OpenAPI tag.
+type Resource struct {
+	Name      string            `json:"name"`
+	Count     Count             `json:"count"`
+	Methods   map[string]*Count `json:"methods"`
+	Endpoints []*Endpoint       `json:"endpoints"`
+}
+
+// Report is the coverage of the specification.
+type Report struct {
+	Count     Count       `json:"count"`
+	Resources []*Resource `json:"resources"`
+	// Stale are the client calls to endpoints missing from the specification
+	Stale []*Call `json:"stale"`
+}
+
+type spec struct {
+	Paths map[string]map[string]struct {
+		Tags       []string `json:"tags"`
+		Deprecated bool     `json:"deprecated"`
+	} `json:"paths"`
+}
+
+func main() {
+	specPath := flag.String("spec", "docs/openapi3.json", "path of the OpenAPI 3 specification")
+	dir := flag.String("dir", ".", "directory of the client package")
+	format := flag.String("format", "markdown", "output format, markdown or json")
+	tags := flag.String("tags", "", "comma separated tags of the resources to report, all by default")
+	flag.Parse()
+
+	report, err := run(*specPath, *dir, *tags)
+	if err != nil {
+		log.Fatalf("fortytwo-coverage: %s", err)
+	}
+
+	switch *format {
+	case "markdown":
+		err = writeMarkdown(os.Stdout, report)
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	default:
+		err = fmt.Errorf("unknown format %q", *format)
+	}
+
+	if err != nil {
+		log.Fatalf("fortytwo-coverage: %s", err)
+	}
+}
+
+func run(specPath, dir, tags string) (*Report, error) {
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var s spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+
+	calls, err := scanCalls(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := map[string][]string{}
+	for _, c := range calls {
+		k := key(c.Method, c.Path)
+		clients[k] = append(clients[k], c.Client)
+	}
+
+	selected := map[string]bool{}
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			selected[tag] = true
+		}
+	}
+
+	report := &Report{Stale: []*Call{}}
+	resources := map[string]*Resource{}
+	known := map[string]bool{}
+
+	for path, ops := range s.Paths {
+		for method, op := range ops {
+			method = strings.ToUpper(method)
+			known[key(method, path)] = true
+
+			tag := ""
+			if len(op.Tags) > 0 {
+				tag = op.Tags[0]
+			}
+
+			if op.Deprecated || len(selected) > 0 && !selected[tag] {
+				continue
+			}
+
+			r, ok := resources[tag]
+			if !ok {
+				r = &Resource{Name: tag, Methods: map[string]*Count{}}
+				resources[tag] = r
+			}
+
+			e := &Endpoint{Method: method, Path: path, Clients: clients[key(method, path)]}
+			sort.Strings(e.Clients)
+
+			r.Endpoints = append(r.Endpoints, e)
+
+			if r.Methods[method] == nil {
+				r.Methods[method] = &Count{}
+			}
+
+			r.Methods[method].add(len(e.Clients) > 0)
+			r.Count.add(len(e.Clients) > 0)
+			report.Count.add(len(e.Clients) > 0)
+		}
+	}
+
+	for _, c := range calls {
+		if !known[key(c.Method, c.Path)] {
+			report.Stale = append(report.Stale, c)
+		}
+	}
+
+	for _, r := range resources {
+		sort.Slice(r.Endpoints, func(i, j int) bool {
+			if r.Endpoints[i].Path != r.Endpoints[j].Path {
+				return r.Endpoints[i].Path < r.Endpoints[j].Path
+			}
+
+			return r.Endpoints[i].Method < r.Endpoints[j].Method
+		})
+
+		report.Resources = append(report.Resources, r)
+	}
+
+	sort.Slice(report.Resources, func(i, j int) bool {
+		return report.Resources[i].Name < report.Resources[j].Name
+	})
+
+	return report, nil
+}
+
+// key identifies an endpoint regardless of the names of its path parameters.
+func key(method, path string) string {
+	if method == "PUT" {
+		method = "PATCH"
+	}
+
+	return method + " " + paramPattern.ReplaceAllString(path, "{}")
+}
+
+func writeMarkdown(w io.Writer, r *Report) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Endpoint coverage\n\n%d of %d endpoints supported (%s).\n\n", r.Count.Supported, r.Count.Total, percent(r.Count))
+
+	b.WriteString("| Resource | " + strings.Join(methods, " | ") + " | Total |\n")
+	b.WriteString("|---" + strings.Repeat("|---", len(methods)+1) + "|\n")
+
+	for _, res := range r.Resources {
+		fmt.Fprintf(&b, "| %s |", res.Name)
+
+		for _, m := range methods {
+			if c, ok := res.Methods[m]; ok {
+				fmt.Fprintf(&b, " %d/%d |", c.Supported, c.Total)
+			} else {
+				b.WriteString(" |")
+			}
+		}
+
+		fmt.Fprintf(&b, " %d/%d |\n", res.Count.Supported, res.Count.Total)
+	}
+
+	b.WriteString("\n## Unsupported endpoints\n")
+
+	for _, res := range r.Resources {
+		if res.Count.Supported == res.Count.Total {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n### %s\n\n", res.Name)
+
+		for _, e := range res.Endpoints {
+			if len(e.Clients) == 0 {
+				fmt.Fprintf(&b, "- `%s %s`\n", e.Method, e.Path)
+			}
+		}
+	}
+
+	if len(r.Stale) > 0 {
+		b.WriteString("\n## Client methods missing from the specification\n\n")
+
+		for _, c := range r.Stale {
+			fmt.Fprintf(&b, "- `%s`: `%s %s` (%s)\n", c.Client, c.Method, c.Path, c.Position)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func percent(c Count) string {
+	if c.Total == 0 {
+		return "0%"
+	}
+
+	return fmt.Sprintf("%.1f%%", 100*float64(c.Supported)/float64(c.Total))
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Call is a request made by a method of the client.
+type Call struct {
+	// Client is the method making the request, e.g. AchievementClient.FindByCursus
+	Client   string `json:"client"`
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Position string `json:"position"`
+}
+
+var verbPattern = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z]`)
+
+// scanCalls returns the calls to Client.request made by the non-test files of a package directory.
+func scanCalls(dir string) ([]*Call, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var calls []*Call
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Body == nil {
+					continue
+				}
+
+				name := fn.Name.Name
+				if fn.Recv != nil && len(fn.Recv.List) > 0 {
+					name = receiverName(fn.Recv.List[0].Type) + "." + name
+				}
+
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok || !isRequest(call) {
+						return true
+					}
+
+					method, ok := httpMethod(call.Args[1])
+					if !ok {
+						return true
+					}
+
+					path, ok := requestPath(call.Args[2])
+					if !ok {
+						return true
+					}
+
+					calls = append(calls, &Call{
+						Client:   name,
+						Method:   method,
+						Path:     "/" + path,
+						Position: fset.Position(call.Pos()).String(),
+					})
+
+					return true
+				})
+			}
+		}
+	}
+
+	return calls, nil
+}
+
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+
+	return ""
+}
+
+// isRequest reports whether a call is c.request(ctx, method, path, token, query, body).
+func isRequest(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+
+	return ok && sel.Sel.Name == "request" && len(call.Args) == 6
+}
+
+// httpMethod resolves http.MethodGet or "GET".
+func httpMethod(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		if strings.HasPrefix(e.Sel.Name, "Method") {
+			return strings.ToUpper(strings.TrimPrefix(e.Sel.Name, "Method")), true
+		}
+	case *ast.BasicLit:
+		if s, err := strconv.Unquote(e.Value); err == nil {
+			return strings.ToUpper(s), true
+		}
+	}
+
+	return "", false
+}
+
+// requestPath resolves "users" or fmt.Sprintf("users/%s", ...), the formatting verbs become {}.
+func requestPath(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		s, err := strconv.Unquote(e.Value)
+
+		return s, err == nil
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Sprintf" || len(e.Args) == 0 {
+			return "", false
+		}
+
+		lit, ok := e.Args[0].(*ast.BasicLit)
+		if !ok {
+			return "", false
+		}
+
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return "", false
+		}
+
+		return verbPattern.ReplaceAllString(s, "{}"), true
+	}
+
+	return "", false
+}
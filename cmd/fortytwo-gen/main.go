@@ -0,0 +1,218 @@
+// Command fortytwo-gen generates the Go services and models of the client from the OpenAPI
+// specification converted from the 42 API documentation.
+//
+// Every tag of the specification is a resource, e.g. "Accreditations". The generator maps the
+// standard operations of a resource to service methods:
+//
+//	GET    /accreditations                  List
+//	GET    /users/{user_id}/accreditations  FindByUser
+//	GET    /accreditations/{id}             FindByID
+//	POST   /accreditations                  Create
+//	PATCH  /accreditations/{id}             Update
+//	DELETE /accreditations/{id}             DeleteByID
+//
+// The other operations are listed in a comment of the generated service. The models are derived
+// from the response schemas and examples of the show and index operations.
+//
+// Usage:
+//
+//	go run ./cmd/fortytwo-gen -spec docs/openapi3.json -tags Accreditations,Groups -out .
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	specPath := flag.String("spec", "docs/openapi3.json", "path of the OpenAPI 3 specification")
+	tags := flag.String("tags", "", "comma separated tags of the resources to generate")
+	out := flag.String("out", ".", "directory of the generated package")
+	pkg := flag.String("package", "fortytwo", "name of the generated package")
+	flag.Parse()
+
+	if err := run(*specPath, *tags, *out, *pkg); err != nil {
+		log.Fatalf("fortytwo-gen: %s", err)
+	}
+}
+
+func run(specPath, tags, out, pkg string) error {
+	spec, err := loadSpec(specPath)
+	if err != nil {
+		return err
+	}
+
+	byTag := map[string][]operation{}
+
+	for path, methods := range spec.Paths {
+		for method, op := range methods {
+			if len(op.Tags) == 0 || op.Deprecated {
+				continue
+			}
+
+			byTag[op.Tags[0]] = append(byTag[op.Tags[0]], operation{path: path, method: method, Operation: op})
+		}
+	}
+
+	known, err := declaredTypes(out)
+	if err != nil {
+		return err
+	}
+
+	var resources []*Resource
+
+	for _, tag := range strings.Split(tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		if _, ok := byTag[tag]; !ok {
+			return fmt.Errorf("unknown tag %q", tag)
+		}
+
+		r := &Resource{Tag: tag, Name: goName(singular(tagResource(tag)))}
+		for _, name := range []string{r.Name, r.ID(), r.Slice(), r.Service(), r.Client(), r.Query(), r.Attributes()} {
+			if known[name] {
+				return fmt.Errorf("%s: type %s is already declared in %s", tag, name, out)
+			}
+		}
+
+		resources = append(resources, r)
+		known[r.Name], known[r.ID()] = true, true
+	}
+
+	if len(resources) == 0 {
+		return fmt.Errorf("no tag to generate")
+	}
+
+	files := map[string][]byte{}
+
+	for i, r := range resources {
+		// The ID types of the whole run are known now
+		r = newResource(r.Tag, byTag[r.Tag], known)
+		resources[i] = r
+
+		schema, example := r.schema()
+
+		m := &models{spec: spec, known: known}
+		r.Model = m.model(r.Name, schema, example)
+		r.Nested = m.out[1:]
+
+		service, err := renderService(pkg, r)
+		if err != nil {
+			return err
+		}
+
+		model, err := renderModel(pkg, r)
+		if err != nil {
+			return err
+		}
+
+		files[r.Key+"_gen.go"] = service
+		files[r.Key+"_model_gen.go"] = model
+	}
+
+	services, err := renderServices(pkg, resources)
+	if err != nil {
+		return err
+	}
+
+	files["services_gen.go"] = services
+
+	return write(out, files)
+}
+
+// schema returns the response schema and example of the show operation, the index one otherwise.
+func (r *Resource) schema() (*Schema, interface{}) {
+	var schema *Schema
+
+	var example interface{}
+
+	for _, m := range r.Methods {
+		switch m.Kind {
+		case kindFindByID:
+			return m.op.success()
+		case kindList:
+			schema, example = m.op.success()
+		}
+	}
+
+	return schema, example
+}
+
+// declaredTypes returns the types declared by the hand-written files of a package directory.
+func declaredTypes(dir string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_gen.go") && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	types := map[string]bool{}
+
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			for _, decl := range f.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					types[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+
+	return types, nil
+}
+
+// write replaces the generated files of dir, removing the ones of resources no longer generated.
+func write(dir string, files map[string][]byte) error {
+	previous, err := filepath.Glob(filepath.Join(dir, "*_gen.go"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range previous {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := files[filepath.Base(path)]; !ok && bytes.HasPrefix(data, []byte(header)) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Model is a Go struct generated from a response schema.
+type Model struct {
+	Name   string
+	Fields []Field
+}
+
+// Field is a field of a generated struct.
+type Field struct {
+	Name     string
+	Type     string
+	JSON     string
+	Optional bool
+}
+
+// models converts schemas to Go types, naming nested objects after their parent.
+type models struct {
+	spec *Spec
+	// known are the type names the nested objects may reuse, hand-written or generated
+	known map[string]bool
+	out   []*Model
+}
+
+// model generates the struct of an object schema, example holds the documented value if any.
+func (m *models) model(name string, schema *Schema, example interface{}) *Model {
+	schema = m.spec.resolve(schema)
+	if schema != nil && schema.Type == "array" {
+		schema = m.spec.resolve(schema.Items)
+		example = first(example)
+	}
+
+	model := &Model{Name: name}
+	m.out = append(m.out, model)
+
+	if schema == nil {
+		return model
+	}
+
+	// The documented example tells the required properties of the schemas without any
+	required := map[string]bool{}
+	for _, k := range schema.Required {
+		required[k] = true
+	}
+
+	if values, ok := example.(map[string]interface{}); ok && schema.Required == nil {
+		for k := range values {
+			required[k] = true
+		}
+	}
+
+	names := map[string]bool{}
+
+	for _, prop := range properties(schema) {
+		fieldName := goName(prop)
+		for i := 2; names[fieldName]; i++ {
+			fieldName = fmt.Sprintf("%s%d", goName(prop), i)
+		}
+
+		names[fieldName] = true
+
+		model.Fields = append(model.Fields, Field{
+			Name:     fieldName,
+			Type:     m.goType(name, prop, schema.Properties[prop], lookup(example, prop)),
+			JSON:     prop,
+			Optional: !required[prop],
+		})
+	}
+
+	return model
+}
+
+// goType returns the Go type of the property of a model.
+func (m *models) goType(parent, prop string, schema *Schema, example interface{}) string {
+	if schema != nil && schema.Ref != "" {
+		name := goName(strings.TrimPrefix(schema.Ref, "#/components/schemas/"))
+		if !m.known[name] {
+			m.known[name] = true
+			m.model(name, schema, nil)
+		}
+
+		return nullable(schema, "*"+name)
+	}
+
+	if schema == nil || schema.Type == "" {
+		if example == nil {
+			return "interface{}"
+		}
+
+		schema = &Schema{Type: typeOf(example)}
+	}
+
+	switch schema.Type {
+	case "boolean":
+		return nullable(schema, "bool")
+	case "integer":
+		return nullable(schema, "int")
+	case "number":
+		if f, ok := example.(float64); ok && f != math.Trunc(f) {
+			return nullable(schema, "float64")
+		}
+
+		return nullable(schema, "int")
+	case "string":
+		if schema.Format == "date-time" || isTime(example) {
+			return nullable(schema, "time.Time")
+		}
+
+		return nullable(schema, "string")
+	case "array":
+		item := m.goType(parent, singular(prop), schema.Items, first(example))
+
+		return "[]" + strings.TrimPrefix(item, "*")
+	case "object":
+		if len(schema.Properties) == 0 {
+			return "map[string]interface{}"
+		}
+
+		name := goName(singular(prop))
+		if !m.known[name] {
+			name = parent + name
+			m.model(name, schema, example)
+		}
+
+		return "*" + name
+	}
+
+	return "interface{}"
+}
+
+func nullable(schema *Schema, t string) string {
+	if schema.Nullable && !strings.HasPrefix(t, "*") {
+		return "*" + t
+	}
+
+	return t
+}
+
+// properties returns the property names of a schema, id first then in alphabetical order.
+func properties(schema *Schema) []string {
+	props := make([]string, 0, len(schema.Properties))
+	for k := range schema.Properties {
+		props = append(props, k)
+	}
+
+	sort.Slice(props, func(i, j int) bool {
+		if (props[i] == "id") != (props[j] == "id") {
+			return props[i] == "id"
+		}
+
+		return props[i] < props[j]
+	})
+
+	return props
+}
+
+func typeOf(v interface{}) string {
+	switch v.(type) {
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+
+	return ""
+}
+
+func isTime(v interface{}) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+
+	_, err := time.Parse(time.RFC3339, s)
+
+	return err == nil
+}
+
+func lookup(v interface{}, key string) interface{} {
+	if obj, ok := v.(map[string]interface{}); ok {
+		return obj[key]
+	}
+
+	return nil
+}
+
+func first(v interface{}) interface{} {
+	if items, ok := v.([]interface{}); ok && len(items) > 0 {
+		return items[0]
+	}
+
+	return nil
+}
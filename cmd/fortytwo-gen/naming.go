@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// initialisms are written upper case in Go identifiers.
+var initialisms = map[string]bool{
+	"id":   true,
+	"ids":  true,
+	"url":  true,
+	"uri":  true,
+	"ip":   true,
+	"api":  true,
+	"http": true,
+	"json": true,
+	"uid":  true,
+}
+
+// invariants are the nouns the 42 API uses for both the singular and the plural.
+var invariants = map[string]bool{
+	"cursus": true,
+	"campus": true,
+	"status": true,
+	"news":   true,
+	"data":   true,
+}
+
+// goName converts a snake case API name such as "validated?" or "user_id" to a Go identifier.
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+
+	for _, w := range words {
+		w = strings.ToLower(w)
+
+		if initialisms[w] {
+			b.WriteString(strings.ToUpper(w))
+
+			continue
+		}
+
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+
+	s := b.String()
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "X" + s
+	}
+
+	return s
+}
+
+// singular returns the singular of the last word of a snake case plural, e.g. projects_users becomes projects_user.
+func singular(plural string) string {
+	i := strings.LastIndex(plural, "_")
+	prefix, word := plural[:i+1], plural[i+1:]
+
+	switch {
+	case invariants[word]:
+	case strings.HasSuffix(word, "ies"):
+		word = strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "sses"), strings.HasSuffix(word, "ches"),
+		strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "xes"):
+		word = strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		word = strings.TrimSuffix(word, "s")
+	}
+
+	return prefix + word
+}
+
+// sliceName returns the name of the slice type of a model, following Achievements, Users and CursusSlice.
+func sliceName(model string) string {
+	if invariants[strings.ToLower(model)] || strings.HasSuffix(model, "s") {
+		return model + "Slice"
+	}
+
+	return model + "s"
+}
+
+// receiver returns the receiver name of the methods of a client type.
+func receiver(name string) string {
+	return strings.ToLower(name[:1])
+}
+
+// tagResource returns the snake case resource of an OpenAPI tag such as "Projects users".
+func tagResource(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), " ", "_")
+}
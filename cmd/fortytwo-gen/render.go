@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+const header = "// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.\n\n"
+
+var funcs = template.FuncMap{
+	"receiver": receiver,
+	"alias": func(name string) string {
+		return strings.ToLower(name[:1]) + name[1:]
+	},
+	"tag": func(f Field) string {
+		if f.Optional {
+			return fmt.Sprintf("`json:\"%s,omitempty\"`", f.JSON)
+		}
+
+		return fmt.Sprintf("`json:\"%s\"`", f.JSON)
+	},
+}
+
+var serviceTemplate = template.Must(template.New("service").Funcs(funcs).Parse(`package {{.Package}}
+
+import (
+	"context"
+	{{- if .Fmt}}
+	"fmt"
+	{{- end}}
+	"net/http"
+	"strconv"
+)
+
+{{with .Resource -}}
+type {{.ID}} int
+
+func (pID {{.ID}}) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type {{.Service}} interface {
+{{- range .Methods}}
+{{- if eq .Kind "list"}}
+	List(context.Context, *{{$.Resource.Query}}) (*{{$.Resource.Slice}}, *PaginationResponse, error)
+{{- else if eq .Kind "find_by"}}
+	{{.Name}}(context.Context, {{.IDType}}) (*{{$.Resource.Slice}}, *PaginationResponse, error)
+{{- else if eq .Kind "find_by_id"}}
+	FindByID(context.Context, {{.IDType}}) (*{{$.Resource.Name}}, error)
+{{- else if eq .Kind "create"}}
+	Create(context.Context, {{$.Resource.Attributes}}) (*{{$.Resource.Name}}, error)
+{{- else if eq .Kind "update"}}
+	Update(context.Context, {{.IDType}}, {{$.Resource.Attributes}}) error
+{{- else if eq .Kind "delete"}}
+	DeleteByID(context.Context, {{.IDType}}) error
+{{- end}}
+{{- end}}
+}
+{{- if .Skipped}}
+
+// Not generated:
+{{- range .Skipped}}
+//   - {{.}}
+{{- end}}
+{{- end}}
+
+type {{.Client}} struct {
+	apiClient *Client
+}
+{{range .Methods}}
+// {{.Doc}}
+{{- if eq .Kind "list"}}
+func ({{$.Resource.Receiver}} *{{$.Resource.Client}}) List(ctx context.Context, req *{{$.Resource.Query}}) (*{{$.Resource.Slice}}, *PaginationResponse, error) {
+	res, err := {{$.Resource.Receiver}}.apiClient.request(ctx, {{.HTTP}}, "{{.Path}}", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handle{{$.Resource.Slice}}PaginatedResponse(res)
+}
+{{- else if eq .Kind "find_by"}}
+func ({{$.Resource.Receiver}} *{{$.Resource.Client}}) {{.Name}}(ctx context.Context, id {{.IDType}}) (*{{$.Resource.Slice}}, *PaginationResponse, error) {
+	res, err := {{$.Resource.Receiver}}.apiClient.request(ctx, {{.HTTP}}, fmt.Sprintf("{{.Path}}", {{.IDString}}), "", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handle{{$.Resource.Slice}}PaginatedResponse(res)
+}
+{{- else if eq .Kind "find_by_id"}}
+func ({{$.Resource.Receiver}} *{{$.Resource.Client}}) FindByID(ctx context.Context, id {{.IDType}}) (*{{$.Resource.Name}}, error) {
+	res, err := {{$.Resource.Receiver}}.apiClient.request(ctx, {{.HTTP}}, fmt.Sprintf("{{.Path}}", {{.IDString}}), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handle{{$.Resource.Name}}Response(res)
+}
+{{- else if eq .Kind "create"}}
+func ({{$.Resource.Receiver}} *{{$.Resource.Client}}) Create(ctx context.Context, attrs {{$.Resource.Attributes}}) (*{{$.Resource.Name}}, error) {
+	res, err := {{$.Resource.Receiver}}.apiClient.request(ctx, {{.HTTP}}, "{{.Path}}", "", nil, map[string]{{$.Resource.Attributes}}{"{{$.Resource.Key}}": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handle{{$.Resource.Name}}Response(res)
+}
+{{- else if eq .Kind "update"}}
+func ({{$.Resource.Receiver}} *{{$.Resource.Client}}) Update(ctx context.Context, id {{.IDType}}, attrs {{$.Resource.Attributes}}) error {
+	res, err := {{$.Resource.Receiver}}.apiClient.request(ctx, {{.HTTP}}, fmt.Sprintf("{{.Path}}", {{.IDString}}), "", nil, map[string]{{$.Resource.Attributes}}{"{{$.Resource.Key}}": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+{{- else if eq .Kind "delete"}}
+func ({{$.Resource.Receiver}} *{{$.Resource.Client}}) DeleteByID(ctx context.Context, id {{.IDType}}) error {
+	res, err := {{$.Resource.Receiver}}.apiClient.request(ctx, {{.HTTP}}, fmt.Sprintf("{{.Path}}", {{.IDString}}), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+{{- end}}
+{{end}}
+func handle{{.Name}}Response(res *http.Response) (*{{.Name}}, error) {
+	var response {{.Name}}
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handle{{.Slice}}PaginatedResponse(res *http.Response) (*{{.Slice}}, *PaginationResponse, error) {
+	var response {{.Slice}}
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
+{{- end}}
+`))
+
+var modelTemplate = template.Must(template.New("model").Funcs(funcs).Parse(`package {{.Package}}
+
+{{if .Time -}}
+import (
+	"encoding/json"
+	"time"
+)
+{{- else -}}
+import "encoding/json"
+{{- end}}
+{{with .Resource}}
+type {{.Slice}} []{{.Name}}
+{{range $.Models}}
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} {{tag .}}
+{{- end}}
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage ` + "`json:\"-\"`" + `
+}
+
+func ({{receiver .Name}} *{{.Name}}) UnmarshalJSON(data []byte) error {
+	type {{alias .Name}} {{.Name}}
+
+	extra, err := unmarshalExtra(data, (*{{alias .Name}})({{receiver .Name}}))
+	{{receiver .Name}}.Extra = extra
+
+	return err
+}
+
+func ({{receiver .Name}} {{.Name}}) MarshalJSON() ([]byte, error) {
+	type {{alias .Name}} {{.Name}}
+
+	return marshalExtra({{alias .Name}}({{receiver .Name}}), {{receiver .Name}}.Extra)
+}
+{{end}}
+type {{.Query}} struct {
+	Pagination *Pagination ` + "`json:\"pagination,omitempty\"`" + `
+	Params     *Params     ` + "`json:\"params,omitempty\"`" + `
+}
+{{- if or (.Has "create") (.Has "update")}}
+
+// {{.Attributes}} are the attributes of the create and update requests, keyed by their API name.
+type {{.Attributes}} map[string]interface{}
+{{- end}}
+{{- end}}
+`))
+
+var servicesTemplate = template.Must(template.New("services").Parse(`package {{.Package}}
+
+// generatedServices holds the services generated from the OpenAPI specification, it is embedded in Client.
+type generatedServices struct {
+{{- range .Resources}}
+	{{.Name}} {{.Service}}
+{{- end}}
+}
+
+func (s *generatedServices) init(c *Client) {
+{{- range .Resources}}
+	s.{{.Name}} = &{{.Client}}{apiClient: c}
+{{- end}}
+}
+`))
+
+func renderService(pkg string, r *Resource) ([]byte, error) {
+	hasFmt := false
+	for _, m := range r.Methods {
+		hasFmt = hasFmt || strings.Contains(m.Path, "%s")
+	}
+
+	return render(serviceTemplate, map[string]interface{}{
+		"Package":  pkg,
+		"Resource": r,
+		"Fmt":      hasFmt,
+	})
+}
+
+func renderModel(pkg string, r *Resource) ([]byte, error) {
+	hasTime := false
+	for _, m := range append([]*Model{r.Model}, r.Nested...) {
+		for _, f := range m.Fields {
+			hasTime = hasTime || strings.Contains(f.Type, "time.Time")
+		}
+	}
+
+	return render(modelTemplate, map[string]interface{}{
+		"Package":  pkg,
+		"Resource": r,
+		"Models":   append([]*Model{r.Model}, r.Nested...),
+		"Time":     hasTime,
+	})
+}
+
+func renderServices(pkg string, resources []*Resource) ([]byte, error) {
+	return render(servicesTemplate, map[string]interface{}{
+		"Package":   pkg,
+		"Resources": resources,
+	})
+}
+
+func render(t *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(header)
+
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w\n%s", t.Name(), err, buf.Bytes())
+	}
+
+	return src, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Kinds of generated methods.
+const (
+	kindList     = "list"
+	kindFindBy   = "find_by"
+	kindFindByID = "find_by_id"
+	kindCreate   = "create"
+	kindUpdate   = "update"
+	kindDelete   = "delete"
+)
+
+// Resource is the service generated for an OpenAPI tag.
+type Resource struct {
+	Tag string
+	// Path is the snake case plural used in the API paths, e.g. projects_users
+	Path string
+	// Key is the snake case singular wrapping the create and update bodies, e.g. projects_user
+	Key string
+
+	Name    string
+	Model   *Model
+	Nested  []*Model
+	Methods []*Method
+	Skipped []string
+}
+
+// Method is a generated service method.
+type Method struct {
+	Kind   string
+	Name   string
+	HTTP   string
+	Path   string
+	Doc    string
+	IDType string
+
+	op *Operation
+}
+
+// IDString returns the expression formatting the ID of the method path.
+func (m *Method) IDString() string {
+	if m.IDType == "int" {
+		return "strconv.Itoa(id)"
+	}
+
+	return "id.String()"
+}
+
+// Type names of the resource.
+func (r *Resource) ID() string         { return r.Name + "ID" }
+func (r *Resource) Slice() string      { return sliceName(r.Name) }
+func (r *Resource) Service() string    { return r.Name + "Service" }
+func (r *Resource) Client() string     { return r.Name + "Client" }
+func (r *Resource) Query() string      { return r.Name + "QueryRequest" }
+func (r *Resource) Attributes() string { return r.Name + "Attributes" }
+func (r *Resource) Receiver() string   { return receiver(r.Name) }
+
+// Has reports whether the resource has a method of the kind.
+func (r *Resource) Has(kind string) bool {
+	for _, m := range r.Methods {
+		if m.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// operation is an operation of the spec with its path and method.
+type operation struct {
+	path   string
+	method string
+	*Operation
+}
+
+// newResource classifies the operations of a tag into service methods.
+// known holds the types declared in the package, to find the ID types of the parent resources.
+func newResource(tag string, ops []operation, known map[string]bool) *Resource {
+	path := tagResource(tag)
+	r := &Resource{
+		Tag:  tag,
+		Path: path,
+		Key:  singular(path),
+		Name: goName(singular(path)),
+	}
+
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].path != ops[j].path {
+			return ops[i].path < ops[j].path
+		}
+
+		return methodOrder(ops[i].method) < methodOrder(ops[j].method)
+	})
+
+	hasPatch := map[string]bool{}
+	for _, op := range ops {
+		if op.method == "patch" {
+			hasPatch[op.path] = true
+		}
+	}
+
+	for _, op := range ops {
+		// PUT and PATCH share the update action, PATCH is preferred
+		if op.method == "put" && hasPatch[op.path] {
+			continue
+		}
+
+		m := r.method(op, known)
+		if m == nil {
+			r.Skipped = append(r.Skipped, strings.ToUpper(op.method)+" "+op.path)
+
+			continue
+		}
+
+		m.op = op.Operation
+		m.HTTP = "http.Method" + goName(op.method)
+		m.Doc = fmt.Sprintf("%s https://api.intra.42.fr/apidoc/2.0/%s/%s.html", goName(op.method), path, op.Summary)
+		r.Methods = append(r.Methods, m)
+	}
+
+	sort.SliceStable(r.Methods, func(i, j int) bool {
+		return kindOrder(r.Methods[i].Kind) < kindOrder(r.Methods[j].Kind)
+	})
+
+	return r
+}
+
+func (r *Resource) method(op operation, known map[string]bool) *Method {
+	segments := strings.Split(strings.Trim(op.path, "/"), "/")
+
+	switch {
+	case len(segments) == 1 && segments[0] == r.Path:
+		switch op.method {
+		case "get":
+			return &Method{Kind: kindList, Name: "List", Path: r.Path}
+		case "post":
+			return &Method{Kind: kindCreate, Name: "Create", Path: r.Path}
+		}
+	case len(segments) == 2 && segments[0] == r.Path && segments[1] == "{id}":
+		m := &Method{Path: r.Path + "/%s", IDType: r.ID()}
+
+		switch op.method {
+		case "get":
+			m.Kind, m.Name = kindFindByID, "FindByID"
+		case "delete":
+			m.Kind, m.Name = kindDelete, "DeleteByID"
+		case "patch", "put":
+			m.Kind, m.Name = kindUpdate, "Update"
+		default:
+			return nil
+		}
+
+		return m
+	case len(segments) == 3 && segments[2] == r.Path && strings.HasPrefix(segments[1], "{") && op.method == "get":
+		parent := singular(segments[0])
+
+		idType := goName(parent) + "ID"
+		if !known[idType] {
+			idType = "int"
+		}
+
+		return &Method{
+			Kind:   kindFindBy,
+			Name:   "FindBy" + goName(parent),
+			Path:   segments[0] + "/%s/" + r.Path,
+			IDType: idType,
+		}
+	}
+
+	return nil
+}
+
+func kindOrder(kind string) int {
+	for i, k := range []string{kindList, kindFindBy, kindFindByID, kindCreate, kindUpdate, kindDelete} {
+		if k == kind {
+			return i
+		}
+	}
+
+	return len(kind)
+}
+
+func methodOrder(method string) int {
+	for i, m := range []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete} {
+		if strings.EqualFold(m, method) {
+			return i
+		}
+	}
+
+	return len(method)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+)
+
+// Spec is the subset of an OpenAPI 3 document read by the generator.
+type Spec struct {
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Tags        []string             `json:"tags"`
+	Deprecated  bool                 `json:"deprecated"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Response struct {
+	Content map[string]*MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema  *Schema     `json:"schema"`
+	Example interface{} `json:"example"`
+}
+
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format"`
+	Nullable   bool               `json:"nullable"`
+	Properties map[string]*Schema `json:"properties"`
+	Required   []string           `json:"required"`
+	Items      *Schema            `json:"items"`
+	Enum       []interface{}      `json:"enum"`
+}
+
+func loadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// resolve follows the $ref of a schema to the component it points at.
+func (s *Spec) resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = s.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+	}
+
+	return schema
+}
+
+// success returns the JSON schema and example of the successful response of an operation.
+func (op *Operation) success() (*Schema, interface{}) {
+	for _, code := range []string{"200", "201"} {
+		res, ok := op.Responses[code]
+		if !ok {
+			continue
+		}
+
+		if mt, ok := res.Content["application/json"]; ok {
+			return mt.Schema, mt.Example
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// queryFlags holds the flags shared by every list command.
+type queryFlags struct {
+	filters   keyValueFlag
+	ranges    keyValueFlag
+	sort      string
+	page      int
+	pageSize  int
+	all       bool
+	shortcuts map[string]*string
+}
+
+func registerQueryFlags(fs *flag.FlagSet, res *resource) *queryFlags {
+	q := &queryFlags{shortcuts: map[string]*string{}}
+
+	fs.Var(&q.filters, "filter", "filter on a field, e.g. --filter campus_id=1,12 (repeatable)")
+	fs.Var(&q.ranges, "range", "select a range of a field, e.g. --range updated_at=2023-01-01,2023-02-01 (repeatable)")
+	fs.StringVar(&q.sort, "sort", "", "comma separated fields to sort on, prefix a field with - to sort descending")
+	fs.IntVar(&q.page, "page", 1, "page number to fetch")
+	fs.IntVar(&q.pageSize, "page-size", 0, "number of items per page, at most 100")
+	fs.BoolVar(&q.all, "all", false, "fetch every page")
+
+	for name, field := range res.shortcuts {
+		q.shortcuts[name] = fs.String(name, "", fmt.Sprintf("shortcut for --filter %s=<value>", field))
+	}
+
+	return q
+}
+
+// params builds the filter, range and sort parameters of the request.
+func (q *queryFlags) params(res *resource) (*fortytwo.Params, error) {
+	p := &fortytwo.Params{
+		Filter: map[string][]string{},
+		Range:  map[string][2]string{},
+	}
+
+	for field, value := range q.filters {
+		p.Filter[field] = strings.Split(value, ",")
+	}
+
+	for name, value := range q.shortcuts {
+		if *value != "" {
+			p.Filter[res.shortcuts[name]] = strings.Split(*value, ",")
+		}
+	}
+
+	for field, value := range q.ranges {
+		bounds := strings.Split(value, ",")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid range %s=%s, expected min,max", field, value)
+		}
+
+		p.Range[field] = [2]string{bounds[0], bounds[1]}
+	}
+
+	if q.sort != "" {
+		p.Sort = strings.Split(q.sort, ",")
+	}
+
+	return p, nil
+}
+
+// keyValueFlag is a repeatable key=value flag.
+type keyValueFlag map[string]string
+
+func (f *keyValueFlag) String() string {
+	pairs := make([]string, 0, len(*f))
+	for k, v := range *f {
+		pairs = append(pairs, k+"="+v)
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+func (f *keyValueFlag) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("expected key=value, got %q", s)
+	}
+
+	if *f == nil {
+		*f = keyValueFlag{}
+	}
+
+	(*f)[k] = v
+
+	return nil
+}
+
+// parseInterleaved parses flags placed before, between or after the positional arguments,
+// which the flag package stops at, and returns the positional arguments.
+func parseInterleaved(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/naofel1/go-fortytwo/auth"
+	"github.com/naofel1/go-fortytwo/config"
+)
+
+// accountCommands act on the user token of a profile rather than on a resource.
+var accountCommands = map[string]func(ctx context.Context, args []string, stdout io.Writer) error{
+	"login":  runLogin,
+	"logout": runLogout,
+	"me":     runMe,
+}
+
+func runLogin(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("ft login", flag.ContinueOnError)
+	pf := registerProfileFlags(fs)
+	noBrowser := fs.Bool("no-browser", false, "only print the authorization URL")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, cfg, err := newClient(ctx, pf)
+	if err != nil {
+		return err
+	}
+
+	store, err := auth.NewFileStore("")
+	if err != nil {
+		return err
+	}
+
+	tok, err := auth.Login(ctx, client, auth.LoginOptions{
+		OpenBrowser: !*noBrowser,
+		Prompt: func(authURL string) {
+			fmt.Fprintf(os.Stderr, "Open the following URL to authorize ft:\n\n  %s\n\nWaiting for the callback on %s...\n", authURL, client.RedirectURL())
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := store.Save(cfg.Profile, tok); err != nil {
+		return err
+	}
+
+	me, err := client.User.Me(ctx, tok.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Logged in as %s (profile %s)\n", me.Login, cfg.Profile)
+
+	return nil
+}
+
+func runLogout(_ context.Context, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("ft logout", flag.ContinueOnError)
+	pf := registerProfileFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	store, err := auth.NewFileStore("")
+	if err != nil {
+		return err
+	}
+
+	f, err := config.Open(pf.config)
+	if err != nil {
+		return err
+	}
+
+	name := f.ProfileName(pf.profile)
+
+	if err := store.Delete(name); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Logged out (profile %s)\n", name)
+
+	return nil
+}
+
+func runMe(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("ft me", flag.ContinueOnError)
+	pf := registerProfileFlags(fs)
+
+	var output, fields string
+
+	fs.StringVar(&output, "o", formatJSON, "output format: json, ndjson, csv or table")
+	fs.StringVar(&output, "output", formatJSON, "output format: json, ndjson, csv or table")
+	fs.StringVar(&fields, "fields", "", "comma separated dotted paths of the csv and table columns")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, cfg, err := newClient(ctx, pf)
+	if err != nil {
+		return err
+	}
+
+	store, err := auth.NewFileStore("")
+	if err != nil {
+		return err
+	}
+
+	tok, err := auth.Token(ctx, client, store, cfg.Profile)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotLoggedIn) {
+			return fmt.Errorf("profile %s is not logged in, run ft login first", cfg.Profile)
+		}
+
+		return err
+	}
+
+	me, err := client.User.Me(ctx, tok.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	p, err := newPrinter(stdout, output, splitFields(fields), true)
+	if err != nil {
+		return err
+	}
+
+	rows, err := toRows(me)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Print(rows); err != nil {
+		return err
+	}
+
+	return p.Flush()
+}
@@ -0,0 +1,248 @@
+// Command ft queries the 42 API from the command line.
+//
+// Usage:
+//
+//	ft <resource> get <id|login|slug> [flags]
+//	ft <resource> list [flags]
+//	ft login [--profile name] [--no-browser]
+//	ft logout [--profile name]
+//	ft me [--profile name]
+//
+// Examples:
+//
+//	ft users get norminet
+//	ft cursus-users list --cursus 21 --campus 1 --all -o table --fields user.login,level
+//	ft projects get libft -o json
+//	ft users list --filter pool_year=2023 --sort -updated_at -o ndjson
+//
+// The client settings are read from the profile selected with --profile in the configuration file,
+// see the config package, and from the FT_API_* environment variables such as FT_API_CLIENT_ID
+// and FT_API_CLIENT_SECRET. ft login runs the OAuth flow through a loopback server listening on
+// the redirect URL of the profile, http://127.0.0.1:4242/callback by default, which must be registered
+// as a redirect URI of the application. The user token is stored in a credential file per profile.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/config"
+)
+
+const defaultRedirectURL = "http://127.0.0.1:4242/callback"
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "ft:", err)
+		}
+
+		os.Exit(1)
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage:")
+	fmt.Fprintln(w, "  ft <resource> get <id|login|slug> [flags]")
+	fmt.Fprintln(w, "  ft <resource> list [flags]")
+	fmt.Fprintln(w, "  ft login [--profile name] [--no-browser]")
+	fmt.Fprintln(w, "  ft logout [--profile name]")
+	fmt.Fprintln(w, "  ft me [--profile name]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Resources:")
+
+	for _, res := range resources {
+		actions := []string{"list"}
+		if res.get != nil {
+			actions = append([]string{"get"}, actions...)
+		}
+
+		fmt.Fprintf(w, "  %-14s %s\n", res.name, strings.Join(actions, ", "))
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) > 0 {
+		if cmd, ok := accountCommands[args[0]]; ok {
+			return cmd(ctx, args[1:], stdout)
+		}
+	}
+
+	if len(args) < 2 {
+		usage(os.Stderr)
+
+		return flag.ErrHelp
+	}
+
+	res, ok := findResource(args[0])
+	if !ok {
+		usage(os.Stderr)
+
+		return fmt.Errorf("unknown resource %q", args[0])
+	}
+
+	action := args[1]
+
+	fs := flag.NewFlagSet("ft "+res.name+" "+action, flag.ContinueOnError)
+	pf := registerProfileFlags(fs)
+
+	var output, fields string
+
+	fs.StringVar(&output, "o", formatJSON, "output format: json, ndjson, csv or table")
+	fs.StringVar(&output, "output", formatJSON, "output format: json, ndjson, csv or table")
+	fs.StringVar(&fields, "fields", "", "comma separated dotted paths of the csv and table columns, e.g. user.login,cursus.slug,level")
+
+	var query *queryFlags
+	if action == "list" {
+		query = registerQueryFlags(fs, res)
+	}
+
+	positional, err := parseInterleaved(fs, args[2:])
+	if err != nil {
+		return err
+	}
+
+	columns := splitFields(fields)
+
+	client, _, err := newClient(ctx, pf)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case action == "get" && res.get != nil:
+		if len(positional) != 1 {
+			return fmt.Errorf("usage: ft %s get <id>", res.name)
+		}
+
+		p, err := newPrinter(stdout, output, columns, true)
+		if err != nil {
+			return err
+		}
+
+		return get(ctx, client, res, positional[0], p)
+	case action == "list":
+		p, err := newPrinter(stdout, output, columns, false)
+		if err != nil {
+			return err
+		}
+
+		return list(ctx, client, res, query, p)
+	default:
+		return fmt.Errorf("unknown action %q for %s", action, res.name)
+	}
+}
+
+// profileFlags select the configuration profile of a command.
+type profileFlags struct {
+	profile string
+	config  string
+}
+
+func registerProfileFlags(fs *flag.FlagSet) *profileFlags {
+	pf := &profileFlags{}
+
+	fs.StringVar(&pf.profile, "profile", "", "configuration and credential profile, defaults to FT_PROFILE or the default profile of the configuration file")
+	fs.StringVar(&pf.config, "config", "", "configuration file, defaults to FT_CONFIG or "+defaultConfigPath())
+
+	return pf
+}
+
+func defaultConfigPath() string {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return "the ft/config.yaml file of the user configuration directory"
+	}
+
+	return path
+}
+
+// newClient returns the client configured by the selected profile, along with its configuration.
+func newClient(ctx context.Context, pf *profileFlags) (*fortytwo.Client, *config.Config, error) {
+	cfg, err := config.Load(pf.config, pf.profile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cfg.RedirectURL == "" {
+		cfg.RedirectURL = defaultRedirectURL
+	}
+
+	client, err := cfg.NewClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, cfg, nil
+}
+
+func splitFields(fields string) []string {
+	if fields == "" {
+		return nil
+	}
+
+	return strings.Split(fields, ",")
+}
+
+func get(ctx context.Context, client *fortytwo.Client, res *resource, id string, p printer) error {
+	v, err := res.get(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := toRows(v)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Print(rows); err != nil {
+		return err
+	}
+
+	return p.Flush()
+}
+
+// list prints the requested page, or every page from it with --all.
+func list(ctx context.Context, client *fortytwo.Client, res *resource, q *queryFlags, p printer) error {
+	params, err := q.params(res)
+	if err != nil {
+		return err
+	}
+
+	pageSize := q.pageSize
+	if pageSize == 0 && q.all {
+		pageSize = 100
+	}
+
+	for page := q.page; ; page++ {
+		v, pag, err := res.list(ctx, client, &fortytwo.Pagination{Cursor: page, PageSize: pageSize}, params)
+		if err != nil {
+			return err
+		}
+
+		rows, err := toRows(v)
+		if err != nil {
+			return err
+		}
+
+		if err := p.Print(rows); err != nil {
+			return err
+		}
+
+		if !q.all || len(rows) == 0 || (pag != nil && !pag.HasNext) || (pageSize != 0 && len(rows) < pageSize) {
+			break
+		}
+	}
+
+	return p.Flush()
+}
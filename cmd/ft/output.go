@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/naofel1/go-fortytwo/export"
+)
+
+// Output formats.
+const (
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+	formatCSV    = "csv"
+	formatTable  = "table"
+)
+
+type row = map[string]interface{}
+
+// printer writes rows as they are fetched, Flush is called once every page has been printed.
+type printer interface {
+	Print(rows []row) error
+	Flush() error
+}
+
+func newPrinter(w io.Writer, format string, fields []string, single bool) (printer, error) {
+	switch format {
+	case formatJSON:
+		return &jsonPrinter{w: w, single: single}, nil
+	case formatNDJSON:
+		return &ndjsonPrinter{enc: json.NewEncoder(w)}, nil
+	case formatCSV:
+		return &csvPrinter{w: csv.NewWriter(w), fields: fields}, nil
+	case formatTable:
+		return &tablePrinter{w: w, fields: fields}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q, expected json, ndjson, csv or table", format)
+	}
+}
+
+// toRows converts the models returned by the library to generic rows.
+func toRows(v interface{}) ([]row, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if bytes.HasPrefix(data, []byte("[")) {
+		var rows []row
+
+		return rows, dec.Decode(&rows)
+	}
+
+	var r row
+
+	return []row{r}, dec.Decode(&r)
+}
+
+// jsonPrinter prints the rows as an indented JSON array, or a single object for get commands.
+type jsonPrinter struct {
+	w      io.Writer
+	single bool
+	rows   []row
+}
+
+func (p *jsonPrinter) Print(rows []row) error {
+	p.rows = append(p.rows, rows...)
+
+	return nil
+}
+
+func (p *jsonPrinter) Flush() error {
+	var v interface{} = p.rows
+	if p.single && len(p.rows) == 1 {
+		v = p.rows[0]
+	}
+
+	if p.rows == nil && !p.single {
+		v = []row{}
+	}
+
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// ndjsonPrinter prints one JSON object per line.
+type ndjsonPrinter struct {
+	enc *json.Encoder
+}
+
+func (p *ndjsonPrinter) Print(rows []row) error {
+	for _, r := range rows {
+		if err := p.enc.Encode(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *ndjsonPrinter) Flush() error {
+	return nil
+}
+
+// csvPrinter prints the selected fields as CSV, the columns default to the fields of the first row.
+type csvPrinter struct {
+	w      *csv.Writer
+	fields []string
+	header bool
+}
+
+func (p *csvPrinter) Print(rows []row) error {
+	for _, r := range rows {
+		if !p.header {
+			if len(p.fields) == 0 {
+				p.fields = export.Columns(r)
+			}
+
+			if err := p.w.Write(p.fields); err != nil {
+				return err
+			}
+
+			p.header = true
+		}
+
+		if err := p.w.Write(export.Texts(r, p.fields)); err != nil {
+			return err
+		}
+	}
+
+	p.w.Flush()
+
+	return p.w.Error()
+}
+
+func (p *csvPrinter) Flush() error {
+	p.w.Flush()
+
+	return p.w.Error()
+}
+
+// tablePrinter prints the selected fields as aligned columns once every row is known.
+type tablePrinter struct {
+	w      io.Writer
+	fields []string
+	rows   []row
+}
+
+func (p *tablePrinter) Print(rows []row) error {
+	p.rows = append(p.rows, rows...)
+
+	return nil
+}
+
+func (p *tablePrinter) Flush() error {
+	if len(p.rows) == 0 {
+		return nil
+	}
+
+	if len(p.fields) == 0 {
+		p.fields = export.Columns(p.rows[0])
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+
+	header := make([]string, len(p.fields))
+	for i, f := range p.fields {
+		header[i] = strings.ToUpper(f)
+	}
+
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for _, r := range p.rows {
+		fmt.Fprintln(tw, strings.Join(export.Texts(r, p.fields), "\t"))
+	}
+
+	return tw.Flush()
+}
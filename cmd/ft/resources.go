@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+type getFunc func(ctx context.Context, c *fortytwo.Client, id string) (interface{}, error)
+
+type listFunc func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error)
+
+// resource is a 42 API resource reachable from the command line.
+type resource struct {
+	name string
+	// shortcuts maps a flag name to the filter it sets, e.g. --campus 1 sets filter[campus_id]=1
+	shortcuts map[string]string
+	get       getFunc
+	list      listFunc
+}
+
+var resources = []resource{
+	{
+		name: "users",
+		shortcuts: map[string]string{
+			"campus":     "primary_campus_id",
+			"pool-year":  "pool_year",
+			"pool-month": "pool_month",
+		},
+		get: func(ctx context.Context, c *fortytwo.Client, login string) (interface{}, error) {
+			return c.User.FindByLogin(ctx, login)
+		},
+		list: func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error) {
+			return c.User.List(ctx, &fortytwo.CursusQueryRequest{Pagination: page, Params: params})
+		},
+	},
+	{
+		name: "cursus-users",
+		shortcuts: map[string]string{
+			"cursus": "cursus_id",
+			"campus": "campus_id",
+			"user":   "user_id",
+		},
+		list: func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error) {
+			return c.CursusUser.List(ctx, &fortytwo.CursusUserQueryRequest{Pagination: page, Params: params})
+		},
+	},
+	{
+		name: "projects",
+		shortcuts: map[string]string{
+			"cursus": "cursus_id",
+		},
+		get: func(ctx context.Context, c *fortytwo.Client, slug string) (interface{}, error) {
+			return c.Project.FindBySlug(ctx, slug)
+		},
+		list: func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error) {
+			return c.Project.List(ctx, &fortytwo.ProjectQueryRequest{Pagination: page, Params: params})
+		},
+	},
+	{
+		name: "locations",
+		shortcuts: map[string]string{
+			"campus": "campus_id",
+			"user":   "user_id",
+			"host":   "host",
+			"active": "active",
+		},
+		get: func(ctx context.Context, c *fortytwo.Client, id string) (interface{}, error) {
+			n, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid location id %q", id)
+			}
+
+			return c.Location.FindByID(ctx, fortytwo.LocationID(n))
+		},
+		list: func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error) {
+			return c.Location.List(ctx, &fortytwo.LocationQueryRequest{Pagination: page, Params: params})
+		},
+	},
+	{
+		name: "achievements",
+		get: func(ctx context.Context, c *fortytwo.Client, id string) (interface{}, error) {
+			n, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid achievement id %q", id)
+			}
+
+			return c.Achievement.FindByID(ctx, fortytwo.AchievementID(n))
+		},
+		list: func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error) {
+			return c.Achievement.List(ctx, &fortytwo.AchievementQueryRequest{Pagination: page, Params: params})
+		},
+	},
+	{
+		name: "cursus",
+		get: func(ctx context.Context, c *fortytwo.Client, id string) (interface{}, error) {
+			n, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cursus id %q", id)
+			}
+
+			return c.Cursus.FindByID(ctx, fortytwo.CursusID(n))
+		},
+		list: func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error) {
+			return c.Cursus.List(ctx, &fortytwo.CursusQueryRequest{Pagination: page, Params: params})
+		},
+	},
+	{
+		name: "titles",
+		get: func(ctx context.Context, c *fortytwo.Client, id string) (interface{}, error) {
+			n, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid title id %q", id)
+			}
+
+			return c.Title.FindByID(ctx, fortytwo.TitleID(n))
+		},
+		list: func(ctx context.Context, c *fortytwo.Client, page *fortytwo.Pagination, params *fortytwo.Params) (interface{}, *fortytwo.PaginationResponse, error) {
+			return c.Title.List(ctx, &fortytwo.TitleQueryRequest{Pagination: page, Params: params})
+		},
+	},
+}
+
+func findResource(name string) (*resource, bool) {
+	for i := range resources {
+		if resources[i].name == name {
+			return &resources[i], true
+		}
+	}
+
+	return nil, false
+}
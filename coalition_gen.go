@@ -0,0 +1,121 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type CoalitionID int
+
+func (pID CoalitionID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type CoalitionService interface {
+	List(context.Context, *CoalitionQueryRequest) (*Coalitions, *PaginationResponse, error)
+	FindByBloc(context.Context, BlocID) (*Coalitions, *PaginationResponse, error)
+	FindByUser(context.Context, UserID) (*Coalitions, *PaginationResponse, error)
+	FindByID(context.Context, CoalitionID) (*Coalition, error)
+	Create(context.Context, CoalitionAttributes) (*Coalition, error)
+	Update(context.Context, CoalitionID, CoalitionAttributes) error
+}
+
+type CoalitionClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/coalitions/index.html
+func (c *CoalitionClient) List(ctx context.Context, req *CoalitionQueryRequest) (*Coalitions, *PaginationResponse, error) {
+	res, err := c.apiClient.request(ctx, http.MethodGet, "coalitions", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleCoalitionsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/coalitions/index.html
+func (c *CoalitionClient) FindByBloc(ctx context.Context, id BlocID) (*Coalitions, *PaginationResponse, error) {
+	res, err := c.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("blocs/%s/coalitions", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleCoalitionsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/coalitions/index.html
+func (c *CoalitionClient) FindByUser(ctx context.Context, id UserID) (*Coalitions, *PaginationResponse, error) {
+	res, err := c.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/coalitions", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleCoalitionsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/coalitions/show.html
+func (c *CoalitionClient) FindByID(ctx context.Context, id CoalitionID) (*Coalition, error) {
+	res, err := c.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("coalitions/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleCoalitionResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/coalitions/create.html
+func (c *CoalitionClient) Create(ctx context.Context, attrs CoalitionAttributes) (*Coalition, error) {
+	res, err := c.apiClient.request(ctx, http.MethodPost, "coalitions", "", nil, map[string]CoalitionAttributes{"coalition": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleCoalitionResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/coalitions/update.html
+func (c *CoalitionClient) Update(ctx context.Context, id CoalitionID, attrs CoalitionAttributes) error {
+	res, err := c.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("coalitions/%s", id.String()), "", nil, map[string]CoalitionAttributes{"coalition": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+func handleCoalitionResponse(res *http.Response) (*Coalition, error) {
+	var response Coalition
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleCoalitionsPaginatedResponse(res *http.Response) (*Coalitions, *PaginationResponse, error) {
+	var response Coalitions
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
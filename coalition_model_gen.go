@@ -0,0 +1,43 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import "encoding/json"
+
+type Coalitions []Coalition
+
+type Coalition struct {
+	ID       int    `json:"id"`
+	Color    string `json:"color"`
+	ImageURL string `json:"image_url"`
+	Name     string `json:"name"`
+	Score    int    `json:"score"`
+	Slug     string `json:"slug"`
+	UserID   int    `json:"user_id"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Coalition) UnmarshalJSON(data []byte) error {
+	type coalition Coalition
+
+	extra, err := unmarshalExtra(data, (*coalition)(c))
+	c.Extra = extra
+
+	return err
+}
+
+func (c Coalition) MarshalJSON() ([]byte, error) {
+	type coalition Coalition
+
+	return marshalExtra(coalition(c), c.Extra)
+}
+
+type CoalitionQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
+
+// CoalitionAttributes are the attributes of the create and update requests, keyed by their API name.
+type CoalitionAttributes map[string]interface{}
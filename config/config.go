@@ -0,0 +1,479 @@
+// Package config loads the settings of a 42 API client from environment variables and
+// from a YAML or JSON file holding named profiles, and builds the configured *fortytwo.Client.
+//
+// A configuration file looks like:
+//
+//	default_profile: campus
+//	profiles:
+//	  campus:
+//	    client_id: u-s4t2ud-...
+//	    client_secret: s-s4t2ud-...
+//	    redirect_url: http://127.0.0.1:4242/callback
+//	    scopes: [public, projects]
+//	    retry: 5
+//	    rate_limit: 2
+//	    cache:
+//	      ttl: 5m
+//	      max_entries: 1000
+//	    credentials:
+//	      - client_id: u-s4t2ud-...
+//	        client_secret: s-s4t2ud-...
+//
+// The environment variables override the values of the selected profile.
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// Environment variables read by FromEnv and Load.
+const (
+	EnvConfig          = "FT_CONFIG"
+	EnvProfile         = "FT_PROFILE"
+	EnvClientID        = "FT_API_CLIENT_ID"
+	EnvClientSecret    = "FT_API_CLIENT_SECRET"
+	EnvRedirectURL     = "FT_API_REDIRECT_URL"
+	EnvScopes          = "FT_API_SCOPES"
+	EnvBaseURL         = "FT_API_BASE_URL"
+	EnvVersion         = "FT_API_VERSION"
+	EnvRetry           = "FT_API_RETRY"
+	EnvRateLimit       = "FT_API_RATE_LIMIT"
+	EnvCacheTTL        = "FT_API_CACHE_TTL"
+	EnvCacheMaxEntries = "FT_API_CACHE_MAX_ENTRIES"
+)
+
+// settingEnvs maps every setting to its environment variable.
+var settingEnvs = map[string]string{
+	"client_id":         EnvClientID,
+	"client_secret":     EnvClientSecret,
+	"redirect_url":      EnvRedirectURL,
+	"scopes":            EnvScopes,
+	"base_url":          EnvBaseURL,
+	"version":           EnvVersion,
+	"retry":             EnvRetry,
+	"rate_limit":        EnvRateLimit,
+	"cache.ttl":         EnvCacheTTL,
+	"cache.max_entries": EnvCacheMaxEntries,
+	"credentials":       "credentials",
+}
+
+// Cache configures the in-memory cache of the API responses.
+type Cache struct {
+	// TTL is a duration such as "30s" or "5m", the cache is disabled when empty
+	TTL        string `json:"ttl" yaml:"ttl"`
+	MaxEntries int    `json:"max_entries" yaml:"max_entries"`
+}
+
+// Config holds the settings of a client.
+type Config struct {
+	// Profile is the name of the profile the configuration was loaded from
+	Profile string `json:"-" yaml:"-"`
+
+	ClientID     string   `json:"client_id" yaml:"client_id"`
+	ClientSecret string   `json:"client_secret" yaml:"client_secret"`
+	RedirectURL  string   `json:"redirect_url" yaml:"redirect_url"`
+	Scopes       []string `json:"scopes" yaml:"scopes"`
+	BaseURL      string   `json:"base_url" yaml:"base_url"`
+	Version      string   `json:"version" yaml:"version"`
+	// Retry is the number of attempts on 429 responses
+	Retry *int `json:"retry" yaml:"retry"`
+	// RateLimit is the number of requests sent per second at most, 0 meaning no limit
+	RateLimit float64 `json:"rate_limit" yaml:"rate_limit"`
+	Cache     Cache   `json:"cache" yaml:"cache"`
+	// Credentials are additional applications the requests are spread over
+	Credentials []fortytwo.Credentials `json:"credentials" yaml:"credentials"`
+
+	// keys maps a setting to where it was read, e.g. "profiles.campus.client_id" or "FT_API_CLIENT_ID"
+	keys map[string]string
+}
+
+// File is the content of a configuration file.
+type File struct {
+	DefaultProfile string             `json:"default_profile" yaml:"default_profile"`
+	Profiles       map[string]*Config `json:"profiles" yaml:"profiles"`
+
+	path string
+}
+
+// ValidationError reports an invalid setting along with the key it was read from.
+type ValidationError struct {
+	Key     string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("config: %s: %s", e.Key, e.Message)
+}
+
+// ValidationErrors is the list of every invalid setting of a configuration.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// DefaultPath returns the path of the configuration file used when FT_CONFIG is not set.
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "ft", "config.yaml"), nil
+}
+
+// Load returns the validated configuration of a profile.
+//
+// The file is the one returned by Open. The profile is the given one, FT_PROFILE,
+// the default_profile of the file or DefaultProfile.
+// The environment variables are then applied over the profile.
+func Load(path, profile string) (*Config, error) {
+	f, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := f.Profile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.FromEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Open parses the configuration file at path, FT_CONFIG or DefaultPath.
+// A missing default file yields an empty configuration.
+func Open(path string) (*File, error) {
+	if path == "" {
+		path = os.Getenv(EnvConfig)
+	}
+
+	explicit := path != ""
+
+	if !explicit {
+		var err error
+		if path, err = DefaultPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := LoadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return &File{}, nil
+	}
+
+	return f, err
+}
+
+// LoadFile parses a configuration file, as JSON if its extension is .json and as YAML otherwise.
+func LoadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{path: path}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(f)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+
+		// An empty YAML document is a valid, empty configuration
+		if err = dec.Decode(f); errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("config: parse %s: %w", path, err)
+	}
+
+	return f, nil
+}
+
+// ProfileName returns the profile selected by name, FT_PROFILE, the default_profile of the file or DefaultProfile.
+func (f *File) ProfileName(name string) string {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+
+	if name == "" {
+		name = f.DefaultProfile
+	}
+
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	return name
+}
+
+// Profile returns the configuration of the profile selected by ProfileName.
+// An empty file yields an empty configuration for the default profile, to be completed by FromEnv.
+func (f *File) Profile(name string) (*Config, error) {
+	name = f.ProfileName(name)
+
+	cfg, ok := f.Profiles[name]
+	if !ok && (len(f.Profiles) > 0 || name != DefaultProfile) {
+		return nil, &ValidationError{Key: "profiles." + name, Message: "profile not found" + f.location()}
+	}
+
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	cfg.Profile = name
+	cfg.keys = map[string]string{}
+
+	for setting, env := range settingEnvs {
+		if ok {
+			cfg.keys[setting] = "profiles." + name + "." + setting
+		} else {
+			// Without profile, the settings can only come from the environment
+			cfg.keys[setting] = env
+		}
+	}
+
+	return cfg, nil
+}
+
+func (f *File) location() string {
+	if f.path == "" {
+		return ""
+	}
+
+	return " in " + f.path
+}
+
+// FromEnv overrides the settings with the environment variables that are set.
+func (c *Config) FromEnv() error {
+	var errs ValidationErrors
+
+	setString := func(env, key string, dst *string) {
+		if v, ok := os.LookupEnv(env); ok {
+			*dst = v
+			c.setKey(key, env)
+		}
+	}
+
+	setString(EnvClientID, "client_id", &c.ClientID)
+	setString(EnvClientSecret, "client_secret", &c.ClientSecret)
+	setString(EnvRedirectURL, "redirect_url", &c.RedirectURL)
+	setString(EnvBaseURL, "base_url", &c.BaseURL)
+	setString(EnvVersion, "version", &c.Version)
+	setString(EnvCacheTTL, "cache.ttl", &c.Cache.TTL)
+
+	if v, ok := os.LookupEnv(EnvScopes); ok {
+		c.Scopes = strings.Fields(strings.ReplaceAll(v, ",", " "))
+		c.setKey("scopes", EnvScopes)
+	}
+
+	if v, ok := os.LookupEnv(EnvRetry); ok {
+		c.setKey("retry", EnvRetry)
+
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			errs = append(errs, &ValidationError{Key: EnvRetry, Message: fmt.Sprintf("%q is not an integer", v)})
+		} else {
+			c.Retry = &n
+		}
+	}
+
+	if v, ok := os.LookupEnv(EnvRateLimit); ok {
+		c.setKey("rate_limit", EnvRateLimit)
+
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs = append(errs, &ValidationError{Key: EnvRateLimit, Message: fmt.Sprintf("%q is not a number", v)})
+		} else {
+			c.RateLimit = n
+		}
+	}
+
+	if v, ok := os.LookupEnv(EnvCacheMaxEntries); ok {
+		c.setKey("cache.max_entries", EnvCacheMaxEntries)
+
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			errs = append(errs, &ValidationError{Key: EnvCacheMaxEntries, Message: fmt.Sprintf("%q is not an integer", v)})
+		} else {
+			c.Cache.MaxEntries = n
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Validate checks every setting and reports all the invalid ones.
+func (c *Config) Validate() error {
+	var errs ValidationErrors
+
+	invalid := func(key, format string, args ...interface{}) {
+		errs = append(errs, &ValidationError{Key: c.key(key), Message: fmt.Sprintf(format, args...)})
+	}
+
+	if c.ClientID == "" {
+		invalid("client_id", "is required")
+	}
+
+	if c.ClientSecret == "" {
+		invalid("client_secret", "is required")
+	}
+
+	if c.RedirectURL != "" {
+		if u, err := url.Parse(c.RedirectURL); err != nil || u.Scheme == "" || u.Host == "" {
+			invalid("redirect_url", "%q is not an absolute URL", c.RedirectURL)
+		}
+	}
+
+	if c.BaseURL != "" {
+		if u, err := url.Parse(c.BaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+			invalid("base_url", "%q is not an absolute URL", c.BaseURL)
+		}
+	}
+
+	for i, scope := range c.Scopes {
+		if err := fortytwo.ScopeValidator(fortytwo.Scope(scope)); err != nil {
+			invalid("scopes", "unknown scope %q at index %d", scope, i)
+		}
+	}
+
+	if c.Retry != nil && *c.Retry < 1 {
+		invalid("retry", "must be at least 1, got %d", *c.Retry)
+	}
+
+	if c.RateLimit < 0 {
+		invalid("rate_limit", "must be positive, got %v", c.RateLimit)
+	}
+
+	if c.Cache.TTL != "" {
+		if ttl, err := time.ParseDuration(c.Cache.TTL); err != nil || ttl < 0 {
+			invalid("cache.ttl", "%q is not a positive duration such as 30s or 5m", c.Cache.TTL)
+		}
+	}
+
+	if c.Cache.MaxEntries < 0 {
+		invalid("cache.max_entries", "must be positive, got %d", c.Cache.MaxEntries)
+	}
+
+	for i, cred := range c.Credentials {
+		if cred.ClientID == "" {
+			invalid("credentials", "client_id of entry %d is required", i)
+		}
+
+		if cred.ClientSecret == "" {
+			invalid("credentials", "client_secret of entry %d is required", i)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Options returns the client options matching the settings, Validate must have succeeded.
+func (c *Config) Options() []fortytwo.ClientOption {
+	var opts []fortytwo.ClientOption
+
+	if c.BaseURL != "" {
+		u, _ := url.Parse(c.BaseURL)
+		opts = append(opts, fortytwo.WithBaseURL(u))
+	}
+
+	if c.Version != "" {
+		opts = append(opts, fortytwo.WithVersion(c.Version))
+	}
+
+	if c.Retry != nil {
+		opts = append(opts, fortytwo.WithRetry(*c.Retry))
+	}
+
+	if c.RateLimit > 0 {
+		opts = append(opts, fortytwo.WithRateLimit(c.RateLimit))
+	}
+
+	if c.Cache.TTL != "" {
+		ttl, _ := time.ParseDuration(c.Cache.TTL)
+		opts = append(opts, fortytwo.WithCache(ttl, c.Cache.MaxEntries))
+	}
+
+	if len(c.Credentials) > 0 {
+		opts = append(opts, fortytwo.WithCredentialPool(c.Credentials...))
+	}
+
+	return opts
+}
+
+// NewClient validates the configuration and returns the configured client,
+// opts are applied after the options derived from the configuration.
+func (c *Config) NewClient(ctx context.Context, opts ...fortytwo.ClientOption) (*fortytwo.Client, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	scopes := c.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{fortytwo.ScopePublic.String()}
+	}
+
+	return fortytwo.NewClient(ctx, c.ClientID, c.ClientSecret, c.RedirectURL, scopes, append(c.Options(), opts...)...)
+}
+
+func (c *Config) setKey(setting, key string) {
+	if c.keys == nil {
+		c.keys = map[string]string{}
+	}
+
+	c.keys[setting] = key
+}
+
+// key returns where a setting was read, the setting name itself for a configuration built in code.
+func (c *Config) key(setting string) string {
+	if key, ok := c.keys[setting]; ok {
+		return key
+	}
+
+	return setting
+}
@@ -2,7 +2,6 @@ package fortytwo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -25,7 +24,7 @@ type CursusClient struct {
 
 // Get https://api.intra.42.fr/apidoc/2.0/cursus/show.html
 func (a *CursusClient) List(ctx context.Context, req *CursusQueryRequest) (*CursusSlice, *PaginationResponse, error) {
-	res, err := a.apiClient.request(ctx, http.MethodGet, "cursus", "", req.Pagination.ToQuery(), nil)
+	res, err := a.apiClient.request(ctx, http.MethodGet, "cursus", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -62,7 +61,7 @@ func (a *CursusClient) DeleteByID(ctx context.Context, id CursusID) (*Cursus, er
 func handleCursusResponse(res *http.Response) (*Cursus, error) {
 	var response Cursus
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -72,7 +71,7 @@ func handleCursusResponse(res *http.Response) (*Cursus, error) {
 func handleCursusSliceResponse(res *http.Response) (*CursusSlice, error) {
 	var response CursusSlice
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -82,7 +81,7 @@ func handleCursusSliceResponse(res *http.Response) (*CursusSlice, error) {
 func handleCursusSlicePaginatedResponse(res *http.Response) (*CursusSlice, *PaginationResponse, error) {
 	var response CursusSlice
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, nil, err
 	}
 
@@ -1,6 +1,7 @@
 package fortytwo
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -14,8 +15,27 @@ type Cursus struct {
 	Name      string     `json:"name"`
 	Slug      string     `json:"slug"`
 	Kind      CursusKind `json:"kind"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Cursus) UnmarshalJSON(data []byte) error {
+	type cursus Cursus
+
+	extra, err := unmarshalExtra(data, (*cursus)(c))
+	c.Extra = extra
+
+	return err
+}
+
+func (c Cursus) MarshalJSON() ([]byte, error) {
+	type cursus Cursus
+
+	return marshalExtra(cursus(c), c.Extra)
 }
 
 type CursusQueryRequest struct {
 	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
 }
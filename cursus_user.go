@@ -2,7 +2,6 @@ package fortytwo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -20,7 +19,7 @@ type CursusUserClient struct {
 
 // Get https://api.intra.42.fr/apidoc/2.0/cursus/show.html
 func (a *CursusUserClient) List(ctx context.Context, req *CursusUserQueryRequest) (*CursusUsers, *PaginationResponse, error) {
-	res, err := a.apiClient.request(ctx, http.MethodGet, "cursus_users", "", req.Pagination.ToQuery(), nil)
+	res, err := a.apiClient.request(ctx, http.MethodGet, "cursus_users", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -57,7 +56,7 @@ func (a *CursusUserClient) FindByCursus(ctx context.Context, id CursusID) (*Curs
 func handleCursusUserResponse(res *http.Response) (*CursusUser, error) {
 	var response CursusUser
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -67,7 +66,7 @@ func handleCursusUserResponse(res *http.Response) (*CursusUser, error) {
 func handleCursusUsersResponse(res *http.Response) (*CursusUsers, error) {
 	var response CursusUsers
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -77,7 +76,7 @@ func handleCursusUsersResponse(res *http.Response) (*CursusUsers, error) {
 func handleCursusUsersPaginatedResponse(res *http.Response) (*CursusUsers, *PaginationResponse, error) {
 	var response CursusUsers
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, nil, err
 	}
 
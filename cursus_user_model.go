@@ -1,6 +1,7 @@
 package fortytwo
 
 import (
+	"encoding/json"
 	"strconv"
 	"time"
 )
@@ -14,18 +15,40 @@ func (pID CursusUserID) String() string {
 type CursusUsers []*CursusUser
 
 type CursusUser struct {
-	ID           int        `json:"id"`
-	BeginAt      time.Time  `json:"begin_at"`
-	EndAt        *time.Time `json:"end_at,omitempty"`
-	Grade        string     `json:"grade"`
-	Level        float64    `json:"level"`
-	Skills       []Skill    `json:"skills"`
-	CursusId     int        `json:"cursus_id"`
-	HasCoalition bool       `json:"has_coalition"`
-	User         User       `json:"user"`
-	Cursus       Cursus     `json:"cursus"`
+	ID           int         `json:"id"`
+	BeginAt      time.Time   `json:"begin_at"`
+	EndAt        *time.Time  `json:"end_at,omitempty"`
+	Grade        *string     `json:"grade"`
+	Level        float64     `json:"level"`
+	Skills       []Skill     `json:"skills"`
+	CursusId     int         `json:"cursus_id"`
+	HasCoalition bool        `json:"has_coalition"`
+	BlackholedAt *time.Time  `json:"blackholed_at,omitempty"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+	User         UserSummary `json:"user"`
+	Cursus       Cursus      `json:"cursus"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CursusUser) UnmarshalJSON(data []byte) error {
+	type cursusUser CursusUser
+
+	extra, err := unmarshalExtra(data, (*cursusUser)(c))
+	c.Extra = extra
+
+	return err
+}
+
+func (c CursusUser) MarshalJSON() ([]byte, error) {
+	type cursusUser CursusUser
+
+	return marshalExtra(cursusUser(c), c.Extra)
 }
 
 type CursusUserQueryRequest struct {
 	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
 }
@@ -1,14 +1,17 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type APIInfo struct {
@@ -90,6 +93,54 @@ type ApiDoc struct {
 	} `json:"docs"`
 }
 
+// securityScheme is the name of the OAuth2 security scheme of the 42 API.
+const securityScheme = "oauth2"
+
+// scopes are the OAuth2 scopes of the 42 API.
+//
+// https://api.intra.42.fr/apidoc/guides/getting_started#scopes
+var scopes = map[string]string{
+	"public":    "Access the user public data",
+	"projects":  "Register the user to projects and exams",
+	"profile":   "Manage the user profile",
+	"elearning": "Access the e-learning videos",
+	"tig":       "Manage the community services of the user",
+	"forum":     "Access the forum",
+}
+
+// generateSecuritySchemes returns the OAuth2 flows of the 42 API.
+func generateSecuritySchemes() map[string]interface{} {
+	return map[string]interface{}{
+		securityScheme: map[string]interface{}{
+			"type": "oauth2",
+			"flows": map[string]interface{}{
+				"authorizationCode": map[string]interface{}{
+					"authorizationUrl": "https://api.intra.42.fr/oauth/authorize",
+					"tokenUrl":         "https://api.intra.42.fr/oauth/token",
+					"scopes":           scopes,
+				},
+				"clientCredentials": map[string]interface{}{
+					"tokenUrl": "https://api.intra.42.fr/oauth/token",
+					"scopes":   scopes,
+				},
+			},
+		},
+	}
+}
+
+// generateSecurity returns the security requirement of a method, the public scope when it
+// requires none.
+func generateSecurity(method Method) []map[string]interface{} {
+	required := method.Metadata.RequiredScopes
+	if len(required) == 0 {
+		required = []string{"public"}
+	}
+
+	return []map[string]interface{}{
+		{securityScheme: required},
+	}
+}
+
 func initializeOpenAPI(apiDoc *ApiDoc, commonPrefix string) map[string]interface{} {
 	return map[string]interface{}{
 		"openapi": "3.0.0",
@@ -103,7 +154,10 @@ func initializeOpenAPI(apiDoc *ApiDoc, commonPrefix string) map[string]interface
 				"url": commonPrefix,
 			},
 		},
-		"paths":    map[string]interface{}{},
+		"paths": map[string]interface{}{},
+		"security": []map[string]interface{}{
+			{securityScheme: []string{"public"}},
+		},
 		"consumes": []string{"application/json"},
 		"produces": []string{"application/json"},
 	}
@@ -195,160 +249,678 @@ func convertApiDocToOpenAPI(apiDoc *ApiDoc) map[string]interface{} {
 		"schemas": map[string]interface{}{
 			"Response": responseSchema,
 		},
+		"securitySchemes": generateSecuritySchemes(),
 	}
 	openAPI["components"] = components
 
+	// Merge the examples of every resource into a shared schema
+	schemas := components["schemas"].(map[string]interface{})
+	refs := map[string]string{}
+
+	for key, resource := range apiDoc.Docs.Resources {
+		if schema := generateComponentSchema(key, resource); schema != nil {
+			schemas[componentName(key)] = schema
+			refs[resource.Name] = "#/components/schemas/" + componentName(key)
+		}
+	}
+
 	// Add the paths to the openAPI
 	for _, resource := range apiDoc.Docs.Resources {
 		for _, method := range resource.Methods {
-			addMethodToOpenAPIPaths(openAPI, commonPrefix, resource, method)
+			addMethodToOpenAPIPaths(openAPI, commonPrefix, resource, method, refs[resource.Name])
 		}
 	}
 
 	return openAPI
 }
 
-func addMethodToOpenAPIPaths(openAPI map[string]interface{}, commonPrefix string, resource Resource, method Method) {
+func addMethodToOpenAPIPaths(openAPI map[string]interface{}, commonPrefix string, resource Resource, method Method, ref string) {
 	paths := openAPI["paths"].(map[string]interface{})
 
 	for _, api := range method.Apis {
-		path := strings.TrimPrefix(api.APIURL, commonPrefix)
-		httpMethod := strings.ToLower(api.HTTPMethod)
-
-		if _, exists := paths[path]; !exists {
-			paths[path] = map[string]interface{}{}
+		// OpenAPI has no optional segments, every combination of the segments is a path
+		for _, apidocPath := range expandOptionalSegments(strings.TrimPrefix(api.APIURL, commonPrefix)) {
+			addAPIToOpenAPIPaths(paths, apidocPath, api, resource, method, ref)
 		}
+	}
+}
+
+func addAPIToOpenAPIPaths(paths map[string]interface{}, apidocPath string, api APIInfo, resource Resource, method Method, ref string) {
+	path, pathParams := convertPath(apidocPath)
+	httpMethod := strings.ToLower(api.HTTPMethod)
+
+	if _, exists := paths[path]; !exists {
+		paths[path] = map[string]interface{}{}
+	}
+
+	operation := map[string]interface{}{
+		"tags":        []string{resource.Name},
+		"summary":     method.Name,
+		"description": method.FullDescription,
+		"operationId": generateOperationID(httpMethod, apidocPath),
+		"parameters":  generateParameters(method, pathParams),
+		"responses":   map[string]interface{}{},
+		"security":    generateSecurity(method),
+	}
+
+	if api.Deprecated != nil {
+		operation["deprecated"] = true
+	}
+
+	// The roles granted to the application allow the call, regardless of the scopes
+	if len(method.Metadata.Roles) > 0 {
+		operation["x-roles"] = method.Metadata.Roles
+	}
+
+	// Add responses
+	for _, example := range method.Examples {
+		responseCode := example.Code
 
-		operation := map[string]interface{}{
-			"tags":        []string{resource.Name},
-			"summary":     method.Name,
-			"description": method.FullDescription,
-			"operationId": generateOperationID(httpMethod, path),
-			"parameters":  []map[string]interface{}{},
-			"responses":   map[string]interface{}{},
+		num, err := strconv.Atoi(responseCode)
+		if err != nil {
+			continue
 		}
 
-		if api.Deprecated != nil {
-			operation["deprecated"] = true
+		// Check if the response code is a valid HTTP status code
+		if http.StatusText(num) == "" {
+			continue
 		}
 
-		// Add parameters
-		for _, param := range method.Params {
-			parameters := operation["parameters"].([]map[string]interface{})
-			swaggerType := convertToOpenAPIType(param.ExpectedType)
-			parameter := map[string]interface{}{
-				"name": param.Name,
-				"in":   "query",
-				"type": swaggerType,
-			}
-			if swaggerType == "array" {
-				parameter["items"] = map[string]interface{}{
-					"type": "string",
-				}
-				parameter["collectionFormat"] = "csv"
-			}
-			parameters = append(parameters, parameter)
-			operation["parameters"] = parameters
+		responses := operation["responses"].(map[string]interface{})
+		response := map[string]interface{}{
+			"description": "",
 		}
 
-		// Add responses
-		for _, example := range method.Examples {
-			responseCode := example.Code
+		if example.ResponseData != nil {
+			schema := generateSchemaFromExample(example.ResponseData)
 
-			num, err := strconv.Atoi(responseCode)
-			if err != nil {
-				continue
+			if ref != "" && isComponentExample(method, example) {
+				schema = map[string]interface{}{"$ref": ref}
+				if _, ok := example.ResponseData.([]interface{}); ok {
+					schema = map[string]interface{}{"type": "array", "items": schema}
+				}
 			}
 
-			// Check if the response code is a valid HTTP status code
-			if http.StatusText(num) == "" {
-				continue
+			response["content"] = map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema":  schema,
+					"example": example.ResponseData,
+				},
 			}
+		}
 
-			responses := operation["responses"].(map[string]interface{})
-			response := map[string]interface{}{
-				"description": "",
-			}
+		responses[responseCode] = response
+	}
 
-			if example.ResponseData != nil {
-				response["content"] = map[string]interface{}{
+	// Add a default response if there are no valid responses
+	if len(operation["responses"].(map[string]interface{})) == 0 {
+		operation["responses"] = map[string]interface{}{
+			"default": map[string]interface{}{
+				"description": "Default response",
+				"content": map[string]interface{}{
 					"application/json": map[string]interface{}{
-						"schema":  generateSchemaFromExample(example.ResponseData),
-						"example": example.ResponseData,
+						"schema": map[string]interface{}{
+							"$ref": "#/components/schemas/Response",
+						},
 					},
+				},
+			},
+		}
+	}
+
+	// Add the operation to the path
+	pathItem := paths[path].(map[string]interface{})
+	pathItem[httpMethod] = operation
+}
+
+// expandOptionalSegments returns the paths matched by an apidoc path with optional segments,
+// e.g. /graph, /graph/on/:field and /graph/on/:field/by/:interval for /graph(/on/:field(/by/:interval)).
+func expandOptionalSegments(path string) []string {
+	open := strings.Index(path, "(")
+	if open < 0 {
+		return []string{path}
+	}
+
+	depth := 0
+	for i := open; i < len(path); i++ {
+		switch path[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+
+		if depth == 0 {
+			prefix, optional, rest := path[:open], path[open+1:i], path[i+1:]
+
+			var paths []string
+			for _, tail := range expandOptionalSegments(rest) {
+				paths = append(paths, prefix+tail)
+			}
+
+			for _, segment := range expandOptionalSegments(optional) {
+				for _, tail := range expandOptionalSegments(rest) {
+					paths = append(paths, prefix+segment+tail)
 				}
 			}
 
-			responses[responseCode] = response
+			return paths
 		}
+	}
 
-		// Add a default response if there are no valid responses
-		if len(operation["responses"].(map[string]interface{})) == 0 {
-			operation["responses"] = map[string]interface{}{
-				"default": map[string]interface{}{
-					"description": "Default response",
-					"content": map[string]interface{}{
-						"application/json": map[string]interface{}{
-							"schema": map[string]interface{}{
-								"$ref": "#/components/schemas/Response",
-							},
-						},
-					},
-				},
-			}
+	// Unbalanced parentheses are kept as they are
+	return []string{path}
+}
+
+// convertPath converts the :param segments of an apidoc path to OpenAPI {param} templates.
+func convertPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+
+	var params []string
+
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			params = append(params, segment[1:])
+			segments[i] = "{" + segment[1:] + "}"
 		}
+	}
+
+	return strings.Join(segments, "/"), params
+}
 
-		// Add the operation to the path
-		pathItem := paths[path].(map[string]interface{})
-		pathItem[httpMethod] = operation
+// generateParameters returns the path parameters of an operation, then its query parameters.
+func generateParameters(method Method, pathParams []string) []map[string]interface{} {
+	documented := map[string]Param{}
+	for _, param := range method.Params {
+		documented[param.Name] = param
 	}
+
+	parameters := []map[string]interface{}{}
+	inPath := map[string]bool{}
+
+	for _, name := range pathParams {
+		inPath[name] = true
+
+		schema := map[string]interface{}{"type": "string"}
+		if param, ok := documented[name]; ok {
+			schema = generateParamSchema(param)
+			delete(schema, "nullable")
+		} else if name == "id" || strings.HasSuffix(name, "_id") {
+			schema = map[string]interface{}{"type": "integer"}
+		}
+
+		parameters = append(parameters, map[string]interface{}{
+			"name":     name,
+			"in":       "path",
+			"required": true,
+			"schema":   schema,
+		})
+	}
+
+	for _, param := range method.Params {
+		if !inPath[param.Name] {
+			parameters = append(parameters, generateQueryParameter(param))
+		}
+	}
+
+	return parameters
 }
 
-func generateSchemaFromExample(example interface{}) map[string]interface{} {
-	if example == nil {
-		return map[string]interface{}{
-			"type": "null",
+// generateQueryParameter returns the query parameter of an apidoc param. The filter, range and
+// page hashes are deep objects keyed by the filterable and rangeable fields, the sort is a comma
+// separated list of the sortable fields, prefixed by - for a descending order.
+func generateQueryParameter(param Param) map[string]interface{} {
+	parameter := map[string]interface{}{
+		"name":     param.Name,
+		"in":       "query",
+		"required": param.Required,
+	}
+
+	keys := metadataKeys(param)
+
+	switch {
+	case param.Name == "filter" && keys != nil, param.Name == "range" && keys != nil:
+		properties := map[string]interface{}{}
+		for _, key := range keys {
+			properties[key] = map[string]interface{}{"type": "string"}
+		}
+
+		parameter["style"] = "deepObject"
+		parameter["explode"] = true
+		parameter["schema"] = map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	case param.Name == "sort" && keys != nil:
+		values := make([]string, 0, 2*len(keys))
+		for _, key := range keys {
+			values = append(values, key, "-"+key)
 		}
+
+		parameter["style"] = "form"
+		parameter["explode"] = false
+		parameter["schema"] = map[string]interface{}{
+			"type":  "array",
+			"items": map[string]interface{}{"type": "string", "enum": values},
+		}
+	default:
+		schema := generateParamSchema(param)
+
+		switch schema["type"] {
+		case "object":
+			parameter["style"] = "deepObject"
+			parameter["explode"] = true
+		case "array":
+			parameter["style"] = "form"
+			parameter["explode"] = false
+		}
+
+		parameter["schema"] = schema
 	}
 
-	switch exampleType := example.(type) {
-	case []interface{}:
-		if len(exampleType) > 0 {
-			return map[string]interface{}{
-				"type":  "array",
-				"items": generateSchemaFromExample(exampleType[0]),
+	return parameter
+}
+
+// generateParamSchema returns the schema of the values accepted by an apidoc param validator.
+func generateParamSchema(param Param) map[string]interface{} {
+	schema := map[string]interface{}{}
+
+	switch validator := param.Validator; {
+	case strings.HasPrefix(validator, "Must be one of"):
+		var values []string
+		for _, match := range codePattern.FindAllStringSubmatch(validator, -1) {
+			values = append(values, match[1])
+		}
+
+		if len(values) == 2 && values[0] == "true" && values[1] == "false" {
+			schema["type"] = "boolean"
+		} else {
+			schema["type"] = "string"
+			schema["enum"] = values
+		}
+	case validator == "Must be Fixnum" || validator == "Must be Integer":
+		schema["type"] = "integer"
+	case validator == "Must be Float":
+		schema["type"] = "number"
+	case validator == "Must be DateTime":
+		schema["type"] = "string"
+		schema["format"] = "date-time"
+	case validator == "Must be Date":
+		schema["type"] = "string"
+		schema["format"] = "date"
+	case validator == "Must be File":
+		schema["type"] = "string"
+		schema["format"] = "binary"
+	case validator == "Must be a Hash":
+		schema = generateObjectSchema(param.Params)
+	case validator == "Must be an Array of nested elements":
+		schema["type"] = "array"
+		schema["items"] = generateObjectSchema(param.Params)
+	case validator == "Must be an array of Integer":
+		schema["type"] = "array"
+		schema["items"] = map[string]interface{}{"type": "integer"}
+	case param.ExpectedType == "array":
+		schema["type"] = "array"
+		schema["items"] = map[string]interface{}{}
+	default:
+		schema["type"] = convertToOpenAPIType(param.ExpectedType)
+	}
+
+	if param.AllowNil {
+		schema["nullable"] = true
+	}
+
+	return schema
+}
+
+// generateObjectSchema returns the schema of a hash of nested params.
+func generateObjectSchema(params []Param) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []string{}
+
+	for _, param := range params {
+		properties[param.Name] = generateParamSchema(param)
+		if param.Required {
+			required = append(required, param.Name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// metadataKeys returns the fields listed by the filter, range or sort metadata of a param,
+// including the additional filters.
+func metadataKeys(param Param) []string {
+	metadata, ok := param.Metadata.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	entry, ok := metadata[param.Name].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	keys := []string{}
+	seen := map[string]bool{}
+
+	if list, ok := entry["keys"].([]interface{}); ok {
+		for _, key := range list {
+			if k, ok := key.(string); ok && !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
 			}
 		}
-		return map[string]interface{}{
-			"type": "array",
+	}
+
+	if additional, ok := entry["additional"].(map[string]interface{}); ok {
+		names := make([]string, 0, len(additional))
+		for name := range additional {
+			names = append(names, name)
 		}
-	case map[string]interface{}:
-		properties := make(map[string]interface{})
-		required := []string{}
-		for key, value := range exampleType {
-			properties[key] = generateSchemaFromExample(value)
-			required = append(required, key)
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				keys = append(keys, name)
+			}
 		}
-		return map[string]interface{}{
-			"type":       "object",
-			"properties": properties,
-			"required":   required,
+	}
+
+	return keys
+}
+
+// schemaNode accumulates the values observed at the same place of several examples.
+type schemaNode struct {
+	nullable bool
+	types    map[string]bool
+	strings  int
+	dates    int
+	days     int
+	objects  int
+	props    map[string]*schemaNode
+	seen     map[string]int
+	items    *schemaNode
+	enum     []string
+}
+
+var (
+	datePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	codePattern = regexp.MustCompile(`<code>(.*?)</code>`)
+)
+
+func newSchemaNode() *schemaNode {
+	return &schemaNode{types: map[string]bool{}, props: map[string]*schemaNode{}, seen: map[string]int{}}
+}
+
+// observe merges an example value into the node.
+func (n *schemaNode) observe(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		n.nullable = true
+	case []interface{}:
+		n.types["array"] = true
+		if n.items == nil {
+			n.items = newSchemaNode()
 		}
-	case float64:
-		return map[string]interface{}{
-			"type": "number",
+
+		for _, item := range v {
+			n.items.observe(item)
+		}
+	case map[string]interface{}:
+		n.types["object"] = true
+		n.objects++
+
+		for key, item := range v {
+			if _, ok := n.props[key]; !ok {
+				n.props[key] = newSchemaNode()
+			}
+
+			n.props[key].observe(item)
+			n.seen[key]++
+		}
+	case json.Number:
+		// The literal keeps the intent of the example, e.g. "level": 0.0 is a number
+		if strings.ContainsAny(v.String(), ".eE") {
+			n.types["number"] = true
+		} else {
+			n.types["integer"] = true
 		}
 	case string:
-		return map[string]interface{}{
-			"type": "string",
+		n.types["string"] = true
+		n.strings++
+
+		if _, err := time.Parse(time.RFC3339, v); err == nil {
+			n.dates++
+		} else if datePattern.MatchString(v) {
+			n.days++
 		}
 	case bool:
-		return map[string]interface{}{
-			"type": "boolean",
+		n.types["boolean"] = true
+	}
+}
+
+// schema returns the OpenAPI schema of the observed values. A property is required when every
+// observed object has it, values observed null are nullable.
+func (n *schemaNode) schema() map[string]interface{} {
+	schema := map[string]interface{}{}
+
+	if n.nullable {
+		schema["nullable"] = true
+	}
+
+	types := n.types
+	if types["integer"] && types["number"] {
+		delete(types, "integer")
+	}
+
+	if len(types) != 1 {
+		// Never observed or observed with different types
+		return schema
+	}
+
+	for t := range types {
+		schema["type"] = t
+	}
+
+	switch schema["type"] {
+	case "array":
+		if n.items != nil && (len(n.items.types) > 0 || n.items.nullable) {
+			schema["items"] = n.items.schema()
+		} else {
+			schema["items"] = map[string]interface{}{}
+		}
+	case "object":
+		properties := map[string]interface{}{}
+		required := []string{}
+
+		for key, prop := range n.props {
+			properties[key] = prop.schema()
+
+			if n.seen[key] == n.objects {
+				required = append(required, key)
+			}
+		}
+
+		sort.Strings(required)
+
+		schema["properties"] = properties
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+	case "string":
+		switch {
+		case n.dates == n.strings:
+			schema["format"] = "date-time"
+		case n.days == n.strings:
+			schema["format"] = "date"
+		}
+
+		if len(n.enum) > 0 {
+			schema["enum"] = n.enum
 		}
-	default:
-		return map[string]interface{}{}
 	}
+
+	return schema
+}
+
+func generateSchemaFromExample(example interface{}) map[string]interface{} {
+	node := newSchemaNode()
+	node.observe(example)
+
+	return node.schema()
+}
+
+// componentMethods are the methods whose examples represent the resource itself.
+var componentMethods = map[string]bool{
+	"index":  true,
+	"show":   true,
+	"create": true,
+	"update": true,
+}
+
+// componentName returns the schema name of a resource, e.g. ProjectsUser for projects_users.
+func componentName(resource string) string {
+	words := strings.Split(resource, "_")
+
+	last := words[len(words)-1]
+	switch {
+	case last == "campus" || last == "cursus" || last == "status":
+	case strings.HasSuffix(last, "ies"):
+		last = strings.TrimSuffix(last, "ies") + "y"
+	case strings.HasSuffix(last, "sses") || strings.HasSuffix(last, "ches") ||
+		strings.HasSuffix(last, "shes") || strings.HasSuffix(last, "xes"):
+		last = strings.TrimSuffix(last, "es")
+	case strings.HasSuffix(last, "s") && !strings.HasSuffix(last, "ss"):
+		last = strings.TrimSuffix(last, "s")
+	}
+
+	words[len(words)-1] = last
+
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// isComponentExample reports whether the response of an example is the resource or a list of it.
+func isComponentExample(method Method, example Example) bool {
+	code, err := strconv.Atoi(example.Code)
+	if err != nil || code < 200 || code >= 300 || !componentMethods[method.Name] {
+		return false
+	}
+
+	switch data := example.ResponseData.(type) {
+	case map[string]interface{}:
+		return len(data) > 0
+	case []interface{}:
+		if len(data) == 0 {
+			return false
+		}
+
+		_, ok := data[0].(map[string]interface{})
+
+		return ok
+	}
+
+	return false
+}
+
+// generateComponentSchema merges every example of a resource into a schema, with the enums of the
+// attributes validated by its create and update methods. It returns nil without examples.
+func generateComponentSchema(key string, resource Resource) map[string]interface{} {
+	node := newSchemaNode()
+
+	for _, method := range resource.Methods {
+		for _, example := range method.Examples {
+			if !isComponentExample(method, example) {
+				continue
+			}
+
+			if items, ok := example.ResponseData.([]interface{}); ok {
+				for _, item := range items {
+					node.observe(item)
+				}
+			} else {
+				node.observe(example.ResponseData)
+			}
+		}
+	}
+
+	if node.objects == 0 {
+		return nil
+	}
+
+	prefix := componentKey(key) + "["
+
+	for _, method := range resource.Methods {
+		for _, param := range flattenParams(method.Params) {
+			if !strings.HasPrefix(param.FullName, prefix) || !strings.HasPrefix(param.Validator, "Must be one of") {
+				continue
+			}
+
+			prop, ok := node.props[strings.TrimSuffix(strings.TrimPrefix(param.FullName, prefix), "]")]
+			if !ok || !prop.types["string"] {
+				continue
+			}
+
+			prop.enum = nil
+			values := map[string]bool{}
+
+			for _, match := range codePattern.FindAllStringSubmatch(param.Validator, -1) {
+				if !values[match[1]] {
+					values[match[1]] = true
+					prop.enum = append(prop.enum, match[1])
+				}
+			}
+		}
+	}
+
+	return node.schema()
+}
+
+// componentKey returns the key wrapping the attributes of a resource, e.g. projects_user.
+func componentKey(key string) string {
+	name := componentName(key)
+
+	var b strings.Builder
+
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+
+		b.WriteRune(r)
+	}
+
+	return strings.ToLower(b.String())
+}
+
+func flattenParams(params []Param) []Param {
+	var flat []Param
+
+	for _, param := range params {
+		flat = append(flat, param)
+		flat = append(flat, flattenParams(param.Params)...)
+	}
+
+	return flat
 }
 
 func convertToOpenAPIType(apiDocType string) string {
@@ -359,8 +931,10 @@ func convertToOpenAPIType(apiDocType string) string {
 		return "number"
 	case "boolean":
 		return "boolean"
+	case "numeric":
+		return "integer"
 	case "hash":
-		return "string"
+		return "object"
 	case "array":
 		return "array"
 	default:
@@ -374,9 +948,12 @@ func parseApiDocFromFile(filename string) (*ApiDoc, error) {
 		return nil, err
 	}
 
+	// Numbers are kept as literals to tell the integers from the numbers written without fraction
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
 	var apiDoc ApiDoc
-	err = json.Unmarshal(data, &apiDoc)
-	if err != nil {
+	if err := dec.Decode(&apiDoc); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,89 @@
+// Command apidocdiff compares two snapshots of the 42 API documentation, apidoc.json or
+// openapi3.json, and reports the added and removed endpoints, and the params, scopes, roles and
+// example response fields that changed.
+//
+// Usage:
+//
+//	go run ./docs/apidocdiff [-markdown CHANGES.md] [-json changes.json] old.json new.json
+//
+// The Markdown changelog is written to the standard output when no output file is given.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func main() {
+	var markdownFile, jsonFile string
+
+	flag.StringVar(&markdownFile, "markdown", "", "Path to the Markdown changelog, - for the standard output")
+	flag.StringVar(&jsonFile, "json", "", "Path to the JSON report, - for the standard output")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] old.json new.json\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if markdownFile == "" && jsonFile == "" {
+		markdownFile = "-"
+	}
+
+	oldSnap, err := loadSnapshot(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Error loading old snapshot: %s", err)
+	}
+
+	newSnap, err := loadSnapshot(flag.Arg(1))
+	if err != nil {
+		log.Fatalf("Error loading new snapshot: %s", err)
+	}
+
+	report := diff(oldSnap, newSnap)
+	report.Old, report.New = flag.Arg(0), flag.Arg(1)
+
+	if markdownFile != "" {
+		if err := writeFile(markdownFile, func(f *os.File) error { return writeMarkdown(f, report) }); err != nil {
+			log.Fatalf("Error writing Markdown changelog: %s", err)
+		}
+	}
+
+	if jsonFile != "" {
+		err := writeFile(jsonFile, func(f *os.File) error {
+			enc := json.NewEncoder(f)
+			enc.SetIndent("", "  ")
+
+			return enc.Encode(report)
+		})
+		if err != nil {
+			log.Fatalf("Error writing JSON report: %s", err)
+		}
+	}
+}
+
+func writeFile(path string, write func(*os.File) error) error {
+	if path == "-" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
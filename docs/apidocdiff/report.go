@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Report lists the changes between two snapshots.
+type Report struct {
+	Old     string            `json:"old"`
+	New     string            `json:"new"`
+	Added   []*Endpoint       `json:"added"`
+	Removed []*Endpoint       `json:"removed"`
+	Changed []*EndpointChange `json:"changed"`
+}
+
+// EndpointChange lists the changes of an endpoint present in both snapshots.
+type EndpointChange struct {
+	Method        string         `json:"method"`
+	Path          string         `json:"path"`
+	AddedParams   []string       `json:"added_params,omitempty"`
+	RemovedParams []string       `json:"removed_params,omitempty"`
+	ChangedParams []*ParamChange `json:"changed_params,omitempty"`
+	AddedScopes   []string       `json:"added_scopes,omitempty"`
+	RemovedScopes []string       `json:"removed_scopes,omitempty"`
+	AddedRoles    []string       `json:"added_roles,omitempty"`
+	RemovedRoles  []string       `json:"removed_roles,omitempty"`
+	AddedFields   []string       `json:"added_fields,omitempty"`
+	RemovedFields []string       `json:"removed_fields,omitempty"`
+}
+
+// ParamChange is a param whose type changed.
+type ParamChange struct {
+	Name string `json:"name"`
+	Old  string `json:"old"`
+	New  string `json:"new"`
+}
+
+func (c *EndpointChange) empty() bool {
+	return len(c.AddedParams)+len(c.RemovedParams)+len(c.ChangedParams)+
+		len(c.AddedScopes)+len(c.RemovedScopes)+len(c.AddedRoles)+len(c.RemovedRoles)+
+		len(c.AddedFields)+len(c.RemovedFields) == 0
+}
+
+// diff compares two snapshots, the endpoints are sorted by path then method.
+func diff(oldSnap, newSnap Snapshot) *Report {
+	report := &Report{
+		Added:   []*Endpoint{},
+		Removed: []*Endpoint{},
+		Changed: []*EndpointChange{},
+	}
+
+	for _, key := range sortedKeys(newSnap) {
+		if _, ok := oldSnap[key]; !ok {
+			report.Added = append(report.Added, newSnap[key])
+		}
+	}
+
+	for _, key := range sortedKeys(oldSnap) {
+		o := oldSnap[key]
+
+		n, ok := newSnap[key]
+		if !ok {
+			report.Removed = append(report.Removed, o)
+
+			continue
+		}
+
+		c := &EndpointChange{Method: o.Method, Path: o.Path}
+		c.AddedParams, c.RemovedParams = compareSets(keys(o.Params), keys(n.Params))
+		c.AddedScopes, c.RemovedScopes = compareSets(o.Scopes, n.Scopes)
+		c.AddedRoles, c.RemovedRoles = compareSets(o.Roles, n.Roles)
+		c.AddedFields, c.RemovedFields = compareSets(o.Fields, n.Fields)
+
+		for _, name := range keys(o.Params) {
+			if t, ok := n.Params[name]; ok && t != o.Params[name] {
+				c.ChangedParams = append(c.ChangedParams, &ParamChange{Name: name, Old: o.Params[name], New: t})
+			}
+		}
+
+		if !c.empty() {
+			report.Changed = append(report.Changed, c)
+		}
+	}
+
+	return report
+}
+
+// compareSets returns the values only in b, then the values only in a.
+func compareSets(a, b []string) (added, removed []string) {
+	inA := map[string]bool{}
+	for _, v := range a {
+		inA[v] = true
+	}
+
+	inB := map[string]bool{}
+	for _, v := range b {
+		inB[v] = true
+
+		if !inA[v] {
+			added = append(added, v)
+		}
+	}
+
+	for _, v := range a {
+		if !inB[v] {
+			removed = append(removed, v)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	return added, removed
+}
+
+func keys(m map[string]string) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+func sortedKeys(s Snapshot) []string {
+	out := make([]string, 0, len(s))
+	for k := range s {
+		out = append(out, k)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := s[out[i]], s[out[j]]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+
+		return a.Method < b.Method
+	})
+
+	return out
+}
+
+// writeMarkdown writes the report as a changelog.
+func writeMarkdown(w io.Writer, r *Report) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# API changes\n\nFrom `%s` to `%s`: %d added, %d removed, %d changed endpoints.\n",
+		r.Old, r.New, len(r.Added), len(r.Removed), len(r.Changed))
+
+	if len(r.Added) > 0 {
+		b.WriteString("\n## Added endpoints\n\n")
+
+		for _, e := range r.Added {
+			fmt.Fprintf(&b, "- `%s %s`%s\n", e.Method, e.Path, resource(e))
+		}
+	}
+
+	if len(r.Removed) > 0 {
+		b.WriteString("\n## Removed endpoints\n\n")
+
+		for _, e := range r.Removed {
+			fmt.Fprintf(&b, "- `%s %s`%s\n", e.Method, e.Path, resource(e))
+		}
+	}
+
+	if len(r.Changed) > 0 {
+		b.WriteString("\n## Changed endpoints\n")
+
+		for _, c := range r.Changed {
+			fmt.Fprintf(&b, "\n### `%s %s`\n\n", c.Method, c.Path)
+			list(&b, "Added params", c.AddedParams)
+			list(&b, "Removed params", c.RemovedParams)
+
+			for _, p := range c.ChangedParams {
+				fmt.Fprintf(&b, "- Changed param `%s`: %s → %s\n", p.Name, p.Old, p.New)
+			}
+
+			list(&b, "Added scopes", c.AddedScopes)
+			list(&b, "Removed scopes", c.RemovedScopes)
+			list(&b, "Added roles", c.AddedRoles)
+			list(&b, "Removed roles", c.RemovedRoles)
+			list(&b, "Added response fields", c.AddedFields)
+			list(&b, "Removed response fields", c.RemovedFields)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func resource(e *Endpoint) string {
+	if e.Resource == "" {
+		return ""
+	}
+
+	return " (" + e.Resource + ")"
+}
+
+func list(b *strings.Builder, title string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "`" + v + "`"
+	}
+
+	fmt.Fprintf(b, "- %s: %s\n", title, strings.Join(quoted, ", "))
+}
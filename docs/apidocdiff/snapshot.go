@@ -0,0 +1,380 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Endpoint is an API call described by a snapshot, in a form shared by apidoc and OpenAPI documents.
+type Endpoint struct {
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Resource string `json:"resource,omitempty"`
+	// Params maps the full name of every param, e.g. page[size], to a description of its type
+	Params map[string]string `json:"params,omitempty"`
+	Scopes []string          `json:"scopes,omitempty"`
+	Roles  []string          `json:"roles,omitempty"`
+	// Fields are the dotted paths of the fields of the documented successful response
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Key identifies the endpoint between two snapshots.
+func (e *Endpoint) Key() string {
+	return e.Method + " " + e.Path
+}
+
+// Snapshot holds the endpoints of a document by key.
+type Snapshot map[string]*Endpoint
+
+var codePattern = regexp.MustCompile(`<code>(.*?)</code>`)
+
+// loadSnapshot reads an apidoc.json or an openapi3.json document.
+func loadSnapshot(path string) (Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	switch {
+	case probe["docs"] != nil:
+		return parseAPIDoc(data)
+	case probe["openapi"] != nil:
+		return parseOpenAPI(data)
+	default:
+		return nil, fmt.Errorf("%s: %w", path, errors.New("neither an apidoc nor an OpenAPI document"))
+	}
+}
+
+type apidocParam struct {
+	FullName  string        `json:"full_name"`
+	Validator string        `json:"validator"`
+	Params    []apidocParam `json:"params"`
+}
+
+type apidoc struct {
+	Docs struct {
+		Resources map[string]struct {
+			Name    string `json:"name"`
+			Methods []struct {
+				Apis []struct {
+					APIURL     string `json:"api_url"`
+					HTTPMethod string `json:"http_method"`
+				} `json:"apis"`
+				Params   []apidocParam `json:"params"`
+				Examples []struct {
+					Code         string      `json:"code"`
+					ResponseData interface{} `json:"response_data"`
+				} `json:"examples"`
+				Metadata struct {
+					RequiredScopes []string `json:"required_scopes"`
+					Roles          []string `json:"roles"`
+				} `json:"metadata"`
+			} `json:"methods"`
+		} `json:"resources"`
+	} `json:"docs"`
+}
+
+func parseAPIDoc(data []byte) (Snapshot, error) {
+	var doc apidoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	snapshot := Snapshot{}
+
+	for _, resource := range doc.Docs.Resources {
+		for _, method := range resource.Methods {
+			params := map[string]string{}
+			flattenAPIDocParams(method.Params, params)
+
+			var fields []string
+
+			for _, example := range method.Examples {
+				if isSuccess(example.Code) && example.ResponseData != nil {
+					fields = responseFields(example.ResponseData)
+
+					break
+				}
+			}
+
+			for _, api := range method.Apis {
+				for _, path := range expandOptionalSegments(strings.TrimPrefix(api.APIURL, "/v2")) {
+					e := &Endpoint{
+						Method:   strings.ToUpper(api.HTTPMethod),
+						Path:     templatePath(path),
+						Resource: resource.Name,
+						Params:   params,
+						Scopes:   sorted(method.Metadata.RequiredScopes),
+						Roles:    sorted(method.Metadata.Roles),
+						Fields:   fields,
+					}
+					snapshot[e.Key()] = e
+				}
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+func flattenAPIDocParams(params []apidocParam, out map[string]string) {
+	for _, p := range params {
+		out[p.FullName] = describeValidator(p.Validator)
+		flattenAPIDocParams(p.Params, out)
+	}
+}
+
+// describeValidator shortens an apidoc validator, e.g. "enum(a, b)" for "Must be one of: <code>a</code>, <code>b</code>.".
+func describeValidator(validator string) string {
+	if strings.HasPrefix(validator, "Must be one of") {
+		var values []string
+		for _, m := range codePattern.FindAllStringSubmatch(validator, -1) {
+			values = append(values, m[1])
+		}
+
+		return "enum(" + strings.Join(values, ", ") + ")"
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(validator, "Must be "), ".")
+}
+
+type openAPISchema struct {
+	Type       string                    `json:"type"`
+	Format     string                    `json:"format"`
+	Enum       []interface{}             `json:"enum"`
+	Items      *openAPISchema            `json:"items"`
+	Properties map[string]*openAPISchema `json:"properties"`
+	Ref        string                    `json:"$ref"`
+}
+
+type openAPI struct {
+	Paths map[string]map[string]struct {
+		Tags       []string `json:"tags"`
+		Parameters []struct {
+			Name   string         `json:"name"`
+			In     string         `json:"in"`
+			Type   string         `json:"type"`
+			Schema *openAPISchema `json:"schema"`
+		} `json:"parameters"`
+		Responses map[string]struct {
+			Content map[string]struct {
+				Example interface{} `json:"example"`
+			} `json:"content"`
+		} `json:"responses"`
+		Security []map[string][]string `json:"security"`
+		Roles    []string              `json:"x-roles"`
+	} `json:"paths"`
+}
+
+func parseOpenAPI(data []byte) (Snapshot, error) {
+	var doc openAPI
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	snapshot := Snapshot{}
+
+	for path, methods := range doc.Paths {
+		for method, op := range methods {
+			e := &Endpoint{
+				Method: strings.ToUpper(method),
+				Path:   templatePath(path),
+				Params: map[string]string{},
+				Roles:  sorted(op.Roles),
+			}
+
+			if len(op.Tags) > 0 {
+				e.Resource = op.Tags[0]
+			}
+
+			for _, p := range op.Parameters {
+				if p.Schema == nil {
+					e.Params[p.Name] = p.Type
+
+					continue
+				}
+
+				flattenSchemaParams(p.Name, p.Schema, e.Params)
+			}
+
+			for _, requirement := range op.Security {
+				for _, scopes := range requirement {
+					e.Scopes = append(e.Scopes, scopes...)
+				}
+			}
+
+			e.Scopes = sorted(e.Scopes)
+
+			codes := make([]string, 0, len(op.Responses))
+			for code := range op.Responses {
+				codes = append(codes, code)
+			}
+
+			sort.Strings(codes)
+
+			for _, code := range codes {
+				if mt, ok := op.Responses[code].Content["application/json"]; ok && isSuccess(code) && mt.Example != nil {
+					e.Fields = responseFields(mt.Example)
+
+					break
+				}
+			}
+
+			snapshot[e.Key()] = e
+		}
+	}
+
+	return snapshot, nil
+}
+
+func flattenSchemaParams(name string, schema *openAPISchema, out map[string]string) {
+	if schema.Type == "object" && len(schema.Properties) > 0 {
+		out[name] = "Hash"
+
+		for prop, s := range schema.Properties {
+			flattenSchemaParams(name+"["+prop+"]", s, out)
+		}
+
+		return
+	}
+
+	out[name] = describeSchema(schema)
+}
+
+func describeSchema(schema *openAPISchema) string {
+	if len(schema.Enum) > 0 {
+		values := make([]string, len(schema.Enum))
+		for i, v := range schema.Enum {
+			values[i] = fmt.Sprint(v)
+		}
+
+		return "enum(" + strings.Join(values, ", ") + ")"
+	}
+
+	switch {
+	case schema.Type == "array" && schema.Items != nil:
+		return "array(" + describeSchema(schema.Items) + ")"
+	case schema.Format != "":
+		return schema.Type + "(" + schema.Format + ")"
+	}
+
+	return schema.Type
+}
+
+// responseFields returns the dotted paths of the fields of a response example, the fields of the
+// first item for a list. Nested lists are marked with [].
+func responseFields(example interface{}) []string {
+	set := map[string]bool{}
+	collectFields("", example, set)
+
+	fields := make([]string, 0, len(set))
+	for f := range set {
+		fields = append(fields, f)
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+func collectFields(prefix string, v interface{}, set map[string]bool) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			name := k
+			if prefix != "" {
+				name = prefix + "." + k
+			}
+
+			set[name] = true
+			collectFields(name, item, set)
+		}
+	case []interface{}:
+		if len(value) == 0 {
+			return
+		}
+
+		name := prefix
+		if name != "" {
+			name += "[]"
+		}
+
+		collectFields(name, value[0], set)
+	}
+}
+
+// expandOptionalSegments returns every path matched by an apidoc path with optional segments.
+func expandOptionalSegments(path string) []string {
+	open := strings.Index(path, "(")
+	if open < 0 {
+		return []string{path}
+	}
+
+	depth := 0
+
+	for i := open; i < len(path); i++ {
+		switch path[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+
+		if depth == 0 {
+			prefix, optional, rest := path[:open], path[open+1:i], path[i+1:]
+			paths := []string{}
+
+			for _, tail := range expandOptionalSegments(rest) {
+				paths = append(paths, prefix+tail)
+
+				for _, segment := range expandOptionalSegments(optional) {
+					paths = append(paths, prefix+segment+tail)
+				}
+			}
+
+			return paths
+		}
+	}
+
+	return []string{path}
+}
+
+// templatePath writes the path parameters as {param}, whether the document uses :param or {param}.
+func templatePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") {
+			segments[i] = "{" + s[1:] + "}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func isSuccess(code string) bool {
+	n, err := strconv.Atoi(code)
+
+	return err == nil && n >= 200 && n < 300
+}
+
+func sorted(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	out := append([]string(nil), values...)
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,288 @@
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type EventService interface {
+	List(context.Context, *EventQueryRequest) (*Events, *PaginationResponse, error)
+	FindByID(context.Context, EventID) (*Event, error)
+	FindByCampus(context.Context, CampusID, *EventQueryRequest) (*Events, *PaginationResponse, error)
+	FindByCursus(context.Context, CursusID, *EventQueryRequest) (*Events, *PaginationResponse, error)
+	FindByUser(context.Context, UserID, *EventQueryRequest) (*Events, *PaginationResponse, error)
+
+	Create(context.Context, *EventAttributes) (*Event, error)
+	Update(context.Context, EventID, *EventAttributes) error
+	DeleteByID(context.Context, EventID) error
+
+	Registrations(context.Context, EventID, *EventQueryRequest) (*EventUsers, *PaginationResponse, error)
+	Subscribe(ctx context.Context, token string, id EventID, userID UserID) (*EventUser, error)
+	Unsubscribe(ctx context.Context, token string, id EventUserID) error
+
+	Waitlist(context.Context, EventID) (*Waitlist, error)
+
+	Feedbacks(context.Context, EventID, *EventQueryRequest) (*Feedbacks, *PaginationResponse, error)
+	CreateFeedback(ctx context.Context, token string, id EventID, attrs *FeedbackAttributes) (*Feedback, error)
+}
+
+type EventClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/events/index.html
+func (a *EventClient) List(ctx context.Context, req *EventQueryRequest) (*Events, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "events", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/events/show.html
+func (a *EventClient) FindByID(ctx context.Context, id EventID) (*Event, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("events/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/events/index.html
+func (a *EventClient) FindByCampus(ctx context.Context, id CampusID, req *EventQueryRequest) (*Events, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("campus/%s/events", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/events/index.html
+func (a *EventClient) FindByCursus(ctx context.Context, id CursusID, req *EventQueryRequest) (*Events, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("cursus/%s/events", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/events/index.html
+func (a *EventClient) FindByUser(ctx context.Context, id UserID, req *EventQueryRequest) (*Events, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/events", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventsPaginatedResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/events/create.html
+func (a *EventClient) Create(ctx context.Context, attrs *EventAttributes) (*Event, error) {
+	if err := EventKindValidator(attrs.Kind); err != nil {
+		return nil, err
+	}
+
+	res, err := a.apiClient.request(ctx, http.MethodPost, "events", "", nil, map[string]*EventAttributes{"event": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/events/update.html
+func (a *EventClient) Update(ctx context.Context, id EventID, attrs *EventAttributes) error {
+	if attrs.Kind != "" {
+		if err := EventKindValidator(attrs.Kind); err != nil {
+			return err
+		}
+	}
+
+	res, err := a.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("events/%s", id.String()), "", nil, map[string]*EventAttributes{"event": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/events/destroy.html
+func (a *EventClient) DeleteByID(ctx context.Context, id EventID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("events/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/events_users/index.html
+func (a *EventClient) Registrations(ctx context.Context, id EventID, req *EventQueryRequest) (*EventUsers, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("events/%s/events_users", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventUsersPaginatedResponse(res)
+}
+
+// Subscribe registers a user to an event, with the token of the user or of a staff member.
+//
+// Post https://api.intra.42.fr/apidoc/2.0/events_users/create.html
+func (a *EventClient) Subscribe(ctx context.Context, tok string, id EventID, userID UserID) (*EventUser, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, "events_users", tok, nil, map[string]map[string]int{
+		"events_user": {"event_id": int(id), "user_id": int(userID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleEventUserResponse(res)
+}
+
+// Unsubscribe removes a registration returned by Subscribe or Registrations.
+//
+// Delete https://api.intra.42.fr/apidoc/2.0/events_users/destroy.html
+func (a *EventClient) Unsubscribe(ctx context.Context, tok string, id EventUserID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("events_users/%s", id.String()), tok, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/waitlists/show.html
+func (a *EventClient) Waitlist(ctx context.Context, id EventID) (*Waitlist, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("events/%s/waitlist", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleWaitlistResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/feedbacks/index.html
+func (a *EventClient) Feedbacks(ctx context.Context, id EventID, req *EventQueryRequest) (*Feedbacks, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("events/%s/feedbacks", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleFeedbacksPaginatedResponse(res)
+}
+
+// CreateFeedback rates an event with the token of a subscribed user.
+//
+// Post https://api.intra.42.fr/apidoc/2.0/feedbacks/create.html
+func (a *EventClient) CreateFeedback(ctx context.Context, tok string, id EventID, attrs *FeedbackAttributes) (*Feedback, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, fmt.Sprintf("events/%s/feedbacks", id.String()), tok, nil, map[string]*FeedbackAttributes{"feedback": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleFeedbackResponse(res)
+}
+
+func handleEventResponse(res *http.Response) (*Event, error) {
+	var response Event
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleEventsPaginatedResponse(res *http.Response) (*Events, *PaginationResponse, error) {
+	var response Events
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
+
+func handleWaitlistResponse(res *http.Response) (*Waitlist, error) {
+	var response Waitlist
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleEventUserResponse(res *http.Response) (*EventUser, error) {
+	var response EventUser
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleEventUsersPaginatedResponse(res *http.Response) (*EventUsers, *PaginationResponse, error) {
+	var response EventUsers
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
+
+func handleFeedbackResponse(res *http.Response) (*Feedback, error) {
+	var response Feedback
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleFeedbacksPaginatedResponse(res *http.Response) (*Feedbacks, *PaginationResponse, error) {
+	var response Feedbacks
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
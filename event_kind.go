@@ -0,0 +1,42 @@
+package fortytwo
+
+import "fmt"
+
+// EventKind defines the type for the kind of an event.
+type EventKind string
+
+// EventKind values.
+const (
+	EventKindPedago       EventKind = "pedago"
+	EventKindRush         EventKind = "rush"
+	EventKindPiscine      EventKind = "piscine"
+	EventKindPartnership  EventKind = "partnership"
+	EventKindMeet         EventKind = "meet"
+	EventKindConference   EventKind = "conference"
+	EventKindMeetUp       EventKind = "meet_up"
+	EventKindEvent        EventKind = "event"
+	EventKindAssociation  EventKind = "association"
+	EventKindSpeedWorking EventKind = "speed_working"
+	EventKindHackathon    EventKind = "hackathon"
+	EventKindWorkshop     EventKind = "workshop"
+	EventKindChallenge    EventKind = "challenge"
+	EventKindOther        EventKind = "other"
+	EventKindExtern       EventKind = "extern"
+)
+
+// String returns the string value for EventKind.
+func (ro EventKind) String() string {
+	return string(ro)
+}
+
+// EventKindValidator is a validator for the "EventKind" field enum values. It is called by EventService.Create.
+func EventKindValidator(ro EventKind) error {
+	switch ro {
+	case EventKindPedago, EventKindRush, EventKindPiscine, EventKindPartnership, EventKindMeet,
+		EventKindConference, EventKindMeetUp, EventKindEvent, EventKindAssociation, EventKindSpeedWorking,
+		EventKindHackathon, EventKindWorkshop, EventKindChallenge, EventKindOther, EventKindExtern:
+		return nil
+	default:
+		return fmt.Errorf("invalid enum value for EventKind field: %q", ro)
+	}
+}
@@ -0,0 +1,147 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type EventID int
+
+func (pID EventID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type Events []Event
+
+type Event struct {
+	ID             EventID   `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Location       string    `json:"location"`
+	Kind           EventKind `json:"kind"`
+	MaxPeople      *int      `json:"max_people"`
+	NbrSubscribers int       `json:"nbr_subscribers"`
+	BeginAt        time.Time `json:"begin_at"`
+	EndAt          time.Time `json:"end_at"`
+	CampusIDs      []int     `json:"campus_ids"`
+	CursusIDs      []int     `json:"cursus_ids"`
+	Themes         []Theme   `json:"themes"`
+	Waitlist       *Waitlist `json:"waitlist"`
+	// ProhibitionOfCancellation is how long before the event the users can no longer unsubscribe, in hours
+	ProhibitionOfCancellation *int      `json:"prohibition_of_cancellation"`
+	CreatedAt                 time.Time `json:"created_at"`
+	UpdatedAt                 time.Time `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type event Event
+
+	extra, err := unmarshalExtra(data, (*event)(e))
+	e.Extra = extra
+
+	return err
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	type event Event
+
+	return marshalExtra(event(e), e.Extra)
+}
+
+// Full reports whether the event reached its maximum number of subscribers.
+func (e *Event) Full() bool {
+	return e.MaxPeople != nil && e.NbrSubscribers >= *e.MaxPeople
+}
+
+// Theme is a topic of events, e.g. AI or Security.
+type Theme struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *Theme) UnmarshalJSON(data []byte) error {
+	type theme Theme
+
+	extra, err := unmarshalExtra(data, (*theme)(t))
+	t.Extra = extra
+
+	return err
+}
+
+func (t Theme) MarshalJSON() ([]byte, error) {
+	type theme Theme
+
+	return marshalExtra(theme(t), t.Extra)
+}
+
+type EventUserID int
+
+func (pID EventUserID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type EventUsers []EventUser
+
+// EventUser is the subscription of a user to an event.
+type EventUser struct {
+	ID      EventUserID  `json:"id"`
+	EventID EventID      `json:"event_id"`
+	UserID  int          `json:"user_id"`
+	User    *UserSummary `json:"user,omitempty"`
+	Event   *Event       `json:"event,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (e *EventUser) UnmarshalJSON(data []byte) error {
+	type eventUser EventUser
+
+	extra, err := unmarshalExtra(data, (*eventUser)(e))
+	e.Extra = extra
+
+	return err
+}
+
+func (e EventUser) MarshalJSON() ([]byte, error) {
+	type eventUser EventUser
+
+	return marshalExtra(eventUser(e), e.Extra)
+}
+
+// EventAttributes are the attributes of the create and update requests of an event.
+type EventAttributes struct {
+	Name                      string                 `json:"name,omitempty"`
+	Description               string                 `json:"description,omitempty"`
+	Location                  string                 `json:"location,omitempty"`
+	Kind                      EventKind              `json:"kind,omitempty"`
+	BeginAt                   *time.Time             `json:"begin_at,omitempty"`
+	EndAt                     *time.Time             `json:"end_at,omitempty"`
+	MaxPeople                 *int                   `json:"max_people,omitempty"`
+	ProhibitionOfCancellation *int                   `json:"prohibition_of_cancellation,omitempty"`
+	ActivateWaitlist          *bool                  `json:"activate_waitlist,omitempty"`
+	CampusIDs                 []int                  `json:"campus_ids,omitempty"`
+	CursusIDs                 []int                  `json:"cursus_ids,omitempty"`
+	EventsThemesAttributes    []EventThemeAttributes `json:"events_themes_attributes,omitempty"`
+}
+
+// EventThemeAttributes adds a theme to an event, or removes it with Destroy.
+type EventThemeAttributes struct {
+	ID      int  `json:"id,omitempty"`
+	ThemeID int  `json:"theme_id"`
+	Destroy bool `json:"_destroy,omitempty"`
+}
+
+type EventQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
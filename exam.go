@@ -0,0 +1,262 @@
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type ExamService interface {
+	List(context.Context, *ExamQueryRequest) (*Exams, *PaginationResponse, error)
+	FindByID(context.Context, ExamID) (*Exam, error)
+	FindByCampus(context.Context, CampusID, *ExamQueryRequest) (*Exams, *PaginationResponse, error)
+	FindByCampusAndCursus(context.Context, CampusID, CursusID, *ExamQueryRequest) (*Exams, *PaginationResponse, error)
+	FindByCursus(context.Context, CursusID, *ExamQueryRequest) (*Exams, *PaginationResponse, error)
+	FindByProject(context.Context, ProjectID, *ExamQueryRequest) (*Exams, *PaginationResponse, error)
+	FindByUser(context.Context, UserID, *ExamQueryRequest) (*Exams, *PaginationResponse, error)
+	FindCurrentByUser(context.Context, UserID) (*Exam, error)
+
+	Create(context.Context, *ExamAttributes) (*Exam, error)
+	Update(context.Context, ExamID, *ExamAttributes) error
+	DeleteByID(context.Context, ExamID) error
+
+	Registrations(context.Context, ExamID, *ExamQueryRequest) (*ExamUsers, *PaginationResponse, error)
+	Register(ctx context.Context, token string, id ExamID, userID UserID) (*ExamUser, error)
+	Unregister(ctx context.Context, token string, id ExamID, examUserID ExamUserID) error
+
+	Waitlist(context.Context, ExamID) (*Waitlist, error)
+}
+
+type ExamClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams/index.html
+func (a *ExamClient) List(ctx context.Context, req *ExamQueryRequest) (*Exams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "exams", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams/show.html
+func (a *ExamClient) FindByID(ctx context.Context, id ExamID) (*Exam, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("exams/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams/index.html
+func (a *ExamClient) FindByCampus(ctx context.Context, id CampusID, req *ExamQueryRequest) (*Exams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("campus/%s/exams", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams/index.html
+func (a *ExamClient) FindByCampusAndCursus(ctx context.Context, campusID CampusID, cursusID CursusID, req *ExamQueryRequest) (*Exams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("campus/%s/cursus/%s/exams", campusID.String(), cursusID.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams/index.html
+func (a *ExamClient) FindByCursus(ctx context.Context, id CursusID, req *ExamQueryRequest) (*Exams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("cursus/%s/exams", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams/index.html
+func (a *ExamClient) FindByProject(ctx context.Context, id ProjectID, req *ExamQueryRequest) (*Exams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("projects/%s/exams", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams/index.html
+func (a *ExamClient) FindByUser(ctx context.Context, id UserID, req *ExamQueryRequest) (*Exams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/exams", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamsPaginatedResponse(res)
+}
+
+// FindCurrentByUser returns the exam a user is taking.
+//
+// Deprecated: the API announced the removal of the endpoint, use FindByUser with a range on begin_at.
+//
+// Get https://api.intra.42.fr/apidoc/2.0/users/exam.html
+func (a *ExamClient) FindCurrentByUser(ctx context.Context, id UserID) (*Exam, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/exam", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/exams/create.html
+func (a *ExamClient) Create(ctx context.Context, attrs *ExamAttributes) (*Exam, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, "exams", "", nil, map[string]*ExamAttributes{"exam": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/exams/update.html
+func (a *ExamClient) Update(ctx context.Context, id ExamID, attrs *ExamAttributes) error {
+	res, err := a.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("exams/%s", id.String()), "", nil, map[string]*ExamAttributes{"exam": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/exams/destroy.html
+func (a *ExamClient) DeleteByID(ctx context.Context, id ExamID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("exams/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/exams_users/index.html
+func (a *ExamClient) Registrations(ctx context.Context, id ExamID, req *ExamQueryRequest) (*ExamUsers, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("exams/%s/exams_users", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamUsersPaginatedResponse(res)
+}
+
+// Register registers a user to an exam, with the token of the user or of a staff member.
+//
+// Post https://api.intra.42.fr/apidoc/2.0/exams_users/create.html
+func (a *ExamClient) Register(ctx context.Context, tok string, id ExamID, userID UserID) (*ExamUser, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, fmt.Sprintf("exams/%s/exams_users", id.String()), tok, nil, map[string]map[string]int{
+		"exams_user": {"user_id": int(userID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleExamUserResponse(res)
+}
+
+// Unregister removes a registration returned by Register or Registrations.
+//
+// Delete https://api.intra.42.fr/apidoc/2.0/exams_users/destroy.html
+func (a *ExamClient) Unregister(ctx context.Context, tok string, id ExamID, examUserID ExamUserID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("exams/%s/exams_users/%s", id.String(), examUserID.String()), tok, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/waitlists/show.html
+func (a *ExamClient) Waitlist(ctx context.Context, id ExamID) (*Waitlist, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("exams/%s/waitlist", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleWaitlistResponse(res)
+}
+
+func handleExamResponse(res *http.Response) (*Exam, error) {
+	var response Exam
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleExamsPaginatedResponse(res *http.Response) (*Exams, *PaginationResponse, error) {
+	var response Exams
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
+
+func handleExamUserResponse(res *http.Response) (*ExamUser, error) {
+	var response ExamUser
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleExamUsersPaginatedResponse(res *http.Response) (*ExamUsers, *PaginationResponse, error) {
+	var response ExamUsers
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
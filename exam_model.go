@@ -0,0 +1,124 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ExamID int
+
+func (pID ExamID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type Exams []Exam
+
+// Exam is an exam session of a campus, open to the users registered on it.
+type Exam struct {
+	ID   ExamID `json:"id"`
+	Name string `json:"name"`
+	// IPRange is the comma separated list of the networks the exam can be taken from
+	IPRange        string    `json:"ip_range"`
+	BeginAt        time.Time `json:"begin_at"`
+	EndAt          time.Time `json:"end_at"`
+	Location       string    `json:"location"`
+	MaxPeople      *int      `json:"max_people"`
+	NbrSubscribers int       `json:"nbr_subscribers"`
+	Campus         *Campus   `json:"campus,omitempty"`
+	Cursus         []Cursus  `json:"cursus"`
+	Projects       []Project `json:"projects"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (e *Exam) UnmarshalJSON(data []byte) error {
+	type exam Exam
+
+	extra, err := unmarshalExtra(data, (*exam)(e))
+	e.Extra = extra
+
+	return err
+}
+
+func (e Exam) MarshalJSON() ([]byte, error) {
+	type exam Exam
+
+	return marshalExtra(exam(e), e.Extra)
+}
+
+// IPRanges returns the networks of IPRange, e.g. 10.11.0.0/16.
+func (e *Exam) IPRanges() []string {
+	var ranges []string
+
+	for _, r := range strings.Split(e.IPRange, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			ranges = append(ranges, r)
+		}
+	}
+
+	return ranges
+}
+
+// Full reports whether the exam reached its maximum number of subscribers.
+func (e *Exam) Full() bool {
+	return e.MaxPeople != nil && e.NbrSubscribers >= *e.MaxPeople
+}
+
+type ExamUserID int
+
+func (pID ExamUserID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type ExamUsers []ExamUser
+
+// ExamUser is the registration of a user to an exam.
+type ExamUser struct {
+	ID     ExamUserID   `json:"id"`
+	ExamID ExamID       `json:"exam_id"`
+	UserID int          `json:"user_id"`
+	User   *UserSummary `json:"user,omitempty"`
+	Exam   *Exam        `json:"exam,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (e *ExamUser) UnmarshalJSON(data []byte) error {
+	type examUser ExamUser
+
+	extra, err := unmarshalExtra(data, (*examUser)(e))
+	e.Extra = extra
+
+	return err
+}
+
+func (e ExamUser) MarshalJSON() ([]byte, error) {
+	type examUser ExamUser
+
+	return marshalExtra(examUser(e), e.Extra)
+}
+
+// ExamAttributes are the attributes of the create and update requests of an exam.
+type ExamAttributes struct {
+	Name     string     `json:"name,omitempty"`
+	BeginAt  *time.Time `json:"begin_at,omitempty"`
+	EndAt    *time.Time `json:"end_at,omitempty"`
+	Location string     `json:"location,omitempty"`
+	// IPRange is the comma separated list of the networks the exam can be taken from, 255 characters at most
+	IPRange    string `json:"ip_range,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	MaxPeople  *int   `json:"max_people,omitempty"`
+	CampusID   int    `json:"campus_id,omitempty"`
+	ProjectIDs []int  `json:"project_ids,omitempty"`
+}
+
+type ExamQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
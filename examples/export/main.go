@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/examples/config"
+	"github.com/naofel1/go-fortytwo/export"
+	"github.com/naofel1/go-fortytwo/source"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cfg := &config.API42{
+		ClientID:     os.Getenv("FT_API_CLIENT_ID"),
+		ClientSecret: os.Getenv("FT_API_CLIENT_SECRET"),
+		RedirectURL:  "redirect_url",
+		Scopes:       []string{"public"},
+	}
+
+	cl := config.Init42API(ctx, cfg)
+
+	// Dump the users and cursus users of the Paris campus
+	users := source.Users(cl)
+	users.Params = &fortytwo.Params{Filter: map[string][]string{"primary_campus_id": {"1"}}}
+
+	cursusUsers := source.CursusUsers(cl)
+	cursusUsers.Params = &fortytwo.Params{Filter: map[string][]string{"campus_id": {"1"}}}
+
+	manifest, err := export.ExportDir(ctx, "dump",
+		export.Job{Source: users, Format: export.FormatNDJSON},
+		export.Job{
+			Source: cursusUsers,
+			Format: export.FormatCSV,
+			Fields: []string{"id", "user.login", "cursus.slug", "level", "begin_at", "blackholed_at"},
+		},
+		export.Job{Source: source.Projects(cl), Format: export.FormatCSV, Fields: []string{"id", "slug", "name", "difficulty"}},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, f := range manifest.Files {
+		log.Printf("%s: %d rows", f.File, f.Rows)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/naofel1/go-fortytwo/examples/mirror
+
+go 1.20
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.22
+	github.com/naofel1/go-fortytwo v0.0.0
+)
+
+require golang.org/x/oauth2 v0.6.0 // indirect
+
+replace github.com/naofel1/go-fortytwo => ../..
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"os"
+	"os/signal"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/examples/config"
+	"github.com/naofel1/go-fortytwo/mirror"
+	"github.com/naofel1/go-fortytwo/source"
+)
+
+func main() {
+	// Interrupting the sync is safe, the next run resumes from the last checkpoint
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	cfg := &config.API42{
+		ClientID:     os.Getenv("FT_API_CLIENT_ID"),
+		ClientSecret: os.Getenv("FT_API_CLIENT_SECRET"),
+		RedirectURL:  "redirect_url",
+		Scopes:       []string{"public"},
+	}
+
+	cl := config.Init42API(ctx, cfg)
+
+	db, err := sql.Open("sqlite3", "fortytwo.db")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	// Only mirror the users of the Paris campus
+	users := source.Users(cl)
+	users.Params = &fortytwo.Params{
+		Filter: map[string][]string{"primary_campus_id": {"1"}},
+	}
+
+	m := mirror.New(db, []source.Source{
+		users,
+		source.CursusUsers(cl),
+		source.Projects(cl),
+		source.Achievements(cl),
+	})
+
+	if err := m.Init(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Sync(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("mirror is up to date")
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/examples/config"
+	"github.com/naofel1/go-fortytwo/source"
+	"github.com/naofel1/go-fortytwo/watch"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	cfg := &config.API42{
+		ClientID:     os.Getenv("FT_API_CLIENT_ID"),
+		ClientSecret: os.Getenv("FT_API_CLIENT_SECRET"),
+		RedirectURL:  "redirect_url",
+		Scopes:       []string{"public"},
+	}
+
+	cl := config.Init42API(ctx, cfg)
+
+	store, err := watch.NewFileStore(".watch")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := watch.New([]source.Source{source.CursusUsers(cl)},
+		watch.WithInterval(5*time.Minute),
+		watch.WithStore(store),
+		watch.WithRateLimiter(cl, 100),
+		watch.WithErrorHandler(func(err error) {
+			log.Println(err)
+		}),
+	)
+
+	err = w.Run(ctx, func(e watch.Event) error {
+		if e.Type == watch.EventDeleted {
+			log.Printf("cursus user %d deleted", e.ID)
+
+			return nil
+		}
+
+		var cu fortytwo.CursusUser
+		if err := e.Decode(&cu); err != nil {
+			return err
+		}
+
+		log.Printf("%s: %s is now level %.2f in %s", e.Type, cu.User.Login, cu.Level, cu.Cursus.Name)
+
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,36 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ExpertiseUsers []ExpertiseUser
+
+type ExpertiseUser struct {
+	ID          int       `json:"id"`
+	ExpertiseID int       `json:"expertise_id"`
+	UserID      int       `json:"user_id"`
+	Value       int       `json:"value"`
+	Interested  bool      `json:"interested"`
+	ContactMe   bool      `json:"contact_me"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (e *ExpertiseUser) UnmarshalJSON(data []byte) error {
+	type expertiseUser ExpertiseUser
+
+	extra, err := unmarshalExtra(data, (*expertiseUser)(e))
+	e.Extra = extra
+
+	return err
+}
+
+func (e ExpertiseUser) MarshalJSON() ([]byte, error) {
+	type expertiseUser ExpertiseUser
+
+	return marshalExtra(expertiseUser(e), e.Extra)
+}
@@ -0,0 +1,253 @@
+// Package export streams the records of list endpoints to NDJSON or CSV files, one page at a time,
+// and describes every export in a manifest.
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/source"
+)
+
+// ManifestFile is the name of the manifest written by ExportDir.
+const ManifestFile = "manifest.json"
+
+// Format defines the type for the format of an export.
+type Format string
+
+// Format values.
+const (
+	FormatNDJSON Format = "ndjson"
+	FormatCSV    Format = "csv"
+)
+
+// String returns the string value for Format.
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "Format" enum values.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatNDJSON, FormatCSV:
+		return nil
+	default:
+		return fmt.Errorf("invalid enum value for Format field: %q", f)
+	}
+}
+
+// Job describes the export of a source.
+type Job struct {
+	Source source.Source
+	Format Format
+	// Fields are the dotted paths of the exported values, e.g. user.login, cursus.slug or level.
+	// NDJSON exports write the whole records when empty, CSV exports the fields of the first record.
+	Fields   []string
+	PageSize int
+}
+
+// FileManifest describes the export of a source.
+type FileManifest struct {
+	Resource   string            `json:"resource"`
+	File       string            `json:"file,omitempty"`
+	Format     Format            `json:"format"`
+	Fields     []string          `json:"fields,omitempty"`
+	Query      map[string]string `json:"query,omitempty"`
+	Rows       int               `json:"rows"`
+	Pages      int               `json:"pages"`
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt time.Time         `json:"finished_at"`
+}
+
+// Manifest describes the files of an export.
+type Manifest struct {
+	ExportedAt time.Time       `json:"exported_at"`
+	Files      []*FileManifest `json:"files"`
+}
+
+// Export streams every record of the job source to w.
+func Export(ctx context.Context, w io.Writer, job Job) (*FileManifest, error) {
+	if err := FormatValidator(job.Format); err != nil {
+		return nil, err
+	}
+
+	// A stable order keeps the pages consistent while records are created during the export
+	src := job.Source
+	if src.Params == nil || len(src.Params.Sort) == 0 {
+		src.Params = src.Params.Merge(&fortytwo.Params{Sort: []string{"id"}})
+	}
+
+	m := &FileManifest{
+		Resource:  src.Name,
+		Format:    job.Format,
+		Fields:    job.Fields,
+		Query:     src.Params.ToQuery(),
+		StartedAt: time.Now(),
+	}
+
+	var write func(obj map[string]interface{}, raw json.RawMessage) error
+
+	var flush func() error
+
+	switch job.Format {
+	case FormatNDJSON:
+		enc := json.NewEncoder(w)
+
+		write = func(obj map[string]interface{}, raw json.RawMessage) error {
+			if len(m.Fields) == 0 {
+				return enc.Encode(raw)
+			}
+
+			return enc.Encode(projection{obj: obj, fields: m.Fields})
+		}
+		flush = func() error { return nil }
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+
+		write = func(obj map[string]interface{}, _ json.RawMessage) error {
+			if m.Rows == 0 {
+				if len(m.Fields) == 0 {
+					m.Fields = Columns(obj)
+				}
+
+				if err := cw.Write(m.Fields); err != nil {
+					return err
+				}
+			}
+
+			return cw.Write(Texts(obj, m.Fields))
+		}
+		flush = func() error {
+			cw.Flush()
+
+			return cw.Error()
+		}
+	}
+
+	err := source.Walk(ctx, src, nil, job.PageSize, 1, func(_ int, records []source.Record) error {
+		m.Pages++
+
+		for _, r := range records {
+			obj, err := decode(r.Data)
+			if err != nil {
+				return fmt.Errorf("export: %s %d: %w", m.Resource, r.ID, err)
+			}
+
+			if err := write(obj, r.Data); err != nil {
+				return err
+			}
+
+			m.Rows++
+		}
+
+		// Release the page before fetching the next one
+		return flush()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.FinishedAt = time.Now()
+
+	return m, nil
+}
+
+// ExportDir exports every job to a file of dir named after the resource, then writes the manifest
+// describing them. The manifest is only written once every export succeeded.
+func ExportDir(ctx context.Context, dir string, jobs ...Job) (*Manifest, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{ExportedAt: time.Now()}
+
+	for _, job := range jobs {
+		name := job.Source.Name + "." + job.Format.String()
+
+		fm, err := exportFile(ctx, filepath.Join(dir, name), job)
+		if err != nil {
+			return nil, fmt.Errorf("export: %s: %w", job.Source.Name, err)
+		}
+
+		fm.File = name
+		manifest.Files = append(manifest.Files, fm)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), data, 0o644); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func exportFile(ctx context.Context, path string, job Job) (*FileManifest, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fm, err := Export(ctx, f, job)
+	if errClose := f.Close(); err == nil && errClose != nil {
+		err = errClose
+	}
+
+	return fm, err
+}
+
+// projection encodes the fields of an object as a flat JSON object, in the order of the fields.
+type projection struct {
+	obj    map[string]interface{}
+	fields []string
+}
+
+func (p projection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, f := range p.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(Lookup(p.obj, f))
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func decode(data json.RawMessage) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var obj map[string]interface{}
+
+	return obj, dec.Decode(&obj)
+}
@@ -0,0 +1,83 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Columns returns the dotted path of every scalar field of an object, nested objects are flattened
+// and arrays are kept whole.
+func Columns(obj map[string]interface{}) []string {
+	var cols []string
+
+	var walk func(prefix string, m map[string]interface{})
+	walk = func(prefix string, m map[string]interface{}) {
+		for k, v := range m {
+			if nested, ok := v.(map[string]interface{}); ok {
+				walk(prefix+k+".", nested)
+
+				continue
+			}
+
+			cols = append(cols, prefix+k)
+		}
+	}
+	walk("", obj)
+
+	sort.Strings(cols)
+
+	return cols
+}
+
+// Lookup returns the value at a dotted path such as cursus.slug, nil if it does not exist.
+func Lookup(obj map[string]interface{}, path string) interface{} {
+	var v interface{} = obj
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		v = m[key]
+	}
+
+	return v
+}
+
+// Text formats a value for a CSV cell, objects and arrays are written as JSON.
+func Text(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case json.Number:
+		return t.String()
+	case bool:
+		if t {
+			return "true"
+		}
+
+		return "false"
+	default:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprint(t)
+		}
+
+		return string(data)
+	}
+}
+
+// Texts formats the values of the fields of an object.
+func Texts(obj map[string]interface{}, fields []string) []string {
+	vals := make([]string, len(fields))
+	for i, f := range fields {
+		vals[i] = Text(Lookup(obj, f))
+	}
+
+	return vals
+}
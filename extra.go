@@ -0,0 +1,230 @@
+package fortytwo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// UnknownFieldsError is returned in strict decoding mode when a response has fields the models do not declare.
+type UnknownFieldsError struct {
+	// Fields are the dotted paths of the unknown fields, [] standing for the items of a list
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("unknown fields in response: %s", strings.Join(e.Fields, ", "))
+}
+
+// WithStrictDecoding makes the responses having fields the models do not declare fail with an *UnknownFieldsError
+func WithStrictDecoding() ClientOption {
+	return func(c *Client) {
+		c.strict = true
+	}
+}
+
+// strictBody marks the body of a response to decode in strict mode.
+type strictBody struct {
+	io.ReadCloser
+}
+
+// markStrict marks the body of res to decode in strict mode when the client is strict.
+func (c *Client) markStrict(res *http.Response) *http.Response {
+	if c.strict {
+		res.Body = &strictBody{ReadCloser: res.Body}
+	}
+
+	return res
+}
+
+// decodeJSON decodes the body of a response, failing on the unknown fields of a strict body.
+func decodeJSON(body io.Reader, v interface{}) error {
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return err
+	}
+
+	if _, ok := body.(*strictBody); !ok {
+		return nil
+	}
+
+	set := map[string]bool{}
+	collectExtra(reflect.ValueOf(v), "", set)
+
+	if len(set) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(set))
+	for f := range set {
+		fields = append(fields, f)
+	}
+
+	sort.Strings(fields)
+
+	return &UnknownFieldsError{Fields: fields}
+}
+
+var extraType = reflect.TypeOf(map[string]json.RawMessage(nil))
+
+// collectExtra adds the keys of the Extra fields found in v to set.
+func collectExtra(v reflect.Value, prefix string, set map[string]bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectExtra(v.Elem(), prefix, set)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectExtra(v.Index(i), prefix+"[]", set)
+		}
+	case reflect.Struct:
+		t := v.Type()
+
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+
+			if f.Name == "Extra" && f.Type == extraType {
+				for key := range v.Field(i).Interface().(map[string]json.RawMessage) {
+					set[joinField(prefix, key)] = true
+				}
+
+				continue
+			}
+
+			name, ok := jsonName(f)
+			if !ok {
+				continue
+			}
+
+			if f.Anonymous && name == "" {
+				collectExtra(v.Field(i), prefix, set)
+			} else {
+				collectExtra(v.Field(i), joinField(prefix, name), set)
+			}
+		}
+	}
+}
+
+// unmarshalExtra decodes data into v, a pointer to a struct without UnmarshalJSON method, and
+// returns the fields of data that v does not declare.
+func unmarshalExtra(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, nil
+	}
+
+	known := declaredFields(reflect.TypeOf(v).Elem())
+	for key := range fields {
+		// encoding/json matches the keys regardless of their case
+		if known[strings.ToLower(key)] {
+			delete(fields, key)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return fields, nil
+}
+
+// marshalExtra encodes v, a struct without MarshalJSON method, with the extra fields.
+func marshalExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	extraData, err := json.Marshal(extra)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.TrimSuffix(data, []byte("}"))
+	if len(data) > 1 {
+		data = append(data, ',')
+	}
+
+	return append(data, extraData[1:]...), nil
+}
+
+var fieldsCache sync.Map
+
+// declaredFields returns the lower case JSON names of the fields of a struct type.
+func declaredFields(t reflect.Type) map[string]bool {
+	if fields, ok := fieldsCache.Load(t); ok {
+		return fields.(map[string]bool)
+	}
+
+	fields := map[string]bool{}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		name, ok := jsonName(f)
+		if !ok {
+			continue
+		}
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				for k := range declaredFields(ft) {
+					fields[k] = true
+				}
+			}
+
+			continue
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+
+		fields[strings.ToLower(name)] = true
+	}
+
+	fieldsCache.Store(t, fields)
+
+	return fields
+}
+
+// jsonName returns the name of the json tag of a field, false when the field is ignored.
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name := strings.Split(tag, ",")[0]
+	if name == "" && !f.Anonymous {
+		name = f.Name
+	}
+
+	return name, true
+}
+
+func joinField(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
@@ -0,0 +1,76 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Feedbacks []Feedback
+
+// Feedback is the rating given to an evaluation or an event.
+type Feedback struct {
+	ID               int              `json:"id"`
+	User             *UserSummary     `json:"user"`
+	FeedbackableType string           `json:"feedbackable_type"`
+	FeedbackableID   int              `json:"feedbackable_id"`
+	Comment          string           `json:"comment"`
+	Rating           int              `json:"rating"`
+	FeedbackDetails  []FeedbackDetail `json:"feedback_details,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (f *Feedback) UnmarshalJSON(data []byte) error {
+	type feedback Feedback
+
+	extra, err := unmarshalExtra(data, (*feedback)(f))
+	f.Extra = extra
+
+	return err
+}
+
+func (f Feedback) MarshalJSON() ([]byte, error) {
+	type feedback Feedback
+
+	return marshalExtra(feedback(f), f.Extra)
+}
+
+// FeedbackDetail is the rate of a feedback for a criterion, e.g. nice, rigorous or punctuality.
+type FeedbackDetail struct {
+	ID   int    `json:"id"`
+	Rate int    `json:"rate"`
+	Kind string `json:"kind"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (f *FeedbackDetail) UnmarshalJSON(data []byte) error {
+	type feedbackDetail FeedbackDetail
+
+	extra, err := unmarshalExtra(data, (*feedbackDetail)(f))
+	f.Extra = extra
+
+	return err
+}
+
+func (f FeedbackDetail) MarshalJSON() ([]byte, error) {
+	type feedbackDetail FeedbackDetail
+
+	return marshalExtra(feedbackDetail(f), f.Extra)
+}
+
+// FeedbackAttributes are the attributes of the create requests of a feedback.
+type FeedbackAttributes struct {
+	Comment                   string                     `json:"comment"`
+	Rating                    *int                       `json:"rating,omitempty"`
+	FeedbackDetailsAttributes []FeedbackDetailAttributes `json:"feedback_details_attributes,omitempty"`
+}
+
+// FeedbackDetailAttributes rates a criterion of a feedback.
+type FeedbackDetailAttributes struct {
+	Rate int    `json:"rate"`
+	Kind string `json:"kind"`
+}
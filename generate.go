@@ -0,0 +1,3 @@
+package fortytwo
+
+//go:generate go run ./cmd/fortytwo-gen -spec docs/openapi3.json -tags Accreditations,Blocs,Coalitions,Groups,Languages -out .
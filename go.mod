@@ -2,7 +2,10 @@ module github.com/naofel1/go-fortytwo
 
 go 1.20
 
-require golang.org/x/oauth2 v0.6.0
+require (
+	golang.org/x/oauth2 v0.6.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	github.com/golang/protobuf v1.5.2 // indirect
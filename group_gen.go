@@ -0,0 +1,121 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type GroupID int
+
+func (pID GroupID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type GroupService interface {
+	List(context.Context, *GroupQueryRequest) (*Groups, *PaginationResponse, error)
+	FindByUser(context.Context, UserID) (*Groups, *PaginationResponse, error)
+	FindByID(context.Context, GroupID) (*Group, error)
+	Create(context.Context, GroupAttributes) (*Group, error)
+	Update(context.Context, GroupID, GroupAttributes) error
+	DeleteByID(context.Context, GroupID) error
+}
+
+type GroupClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/groups/index.html
+func (g *GroupClient) List(ctx context.Context, req *GroupQueryRequest) (*Groups, *PaginationResponse, error) {
+	res, err := g.apiClient.request(ctx, http.MethodGet, "groups", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleGroupsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/groups/index.html
+func (g *GroupClient) FindByUser(ctx context.Context, id UserID) (*Groups, *PaginationResponse, error) {
+	res, err := g.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/groups", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleGroupsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/groups/show.html
+func (g *GroupClient) FindByID(ctx context.Context, id GroupID) (*Group, error) {
+	res, err := g.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("groups/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleGroupResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/groups/create.html
+func (g *GroupClient) Create(ctx context.Context, attrs GroupAttributes) (*Group, error) {
+	res, err := g.apiClient.request(ctx, http.MethodPost, "groups", "", nil, map[string]GroupAttributes{"group": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleGroupResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/groups/update.html
+func (g *GroupClient) Update(ctx context.Context, id GroupID, attrs GroupAttributes) error {
+	res, err := g.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("groups/%s", id.String()), "", nil, map[string]GroupAttributes{"group": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/groups/destroy.html
+func (g *GroupClient) DeleteByID(ctx context.Context, id GroupID) error {
+	res, err := g.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("groups/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+func handleGroupResponse(res *http.Response) (*Group, error) {
+	var response Group
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleGroupsPaginatedResponse(res *http.Response) (*Groups, *PaginationResponse, error) {
+	var response Groups
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
@@ -0,0 +1,38 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import "encoding/json"
+
+type Groups []Group
+
+type Group struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (g *Group) UnmarshalJSON(data []byte) error {
+	type group Group
+
+	extra, err := unmarshalExtra(data, (*group)(g))
+	g.Extra = extra
+
+	return err
+}
+
+func (g Group) MarshalJSON() ([]byte, error) {
+	type group Group
+
+	return marshalExtra(group(g), g.Extra)
+}
+
+type GroupQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
+
+// GroupAttributes are the attributes of the create and update requests, keyed by their API name.
+type GroupAttributes map[string]interface{}
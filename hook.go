@@ -0,0 +1,34 @@
+package fortytwo
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// ResponseHook is called with every successful response of the API and its body, before the body
+// is decoded. The body of the response can still be read by the client afterwards.
+type ResponseHook func(req *http.Request, res *http.Response, body []byte)
+
+// WithResponseHook calls hook with every successful response, e.g. to validate it against the
+// OpenAPI specification with the validate package
+func WithResponseHook(hook ResponseHook) ClientOption {
+	return func(c *Client) {
+		c.responseHook = hook
+	}
+}
+
+// callResponseHook buffers the body of res for the hook, then rewinds it.
+func (c *Client) callResponseHook(req *http.Request, res *http.Response) error {
+	data, err := io.ReadAll(res.Body)
+	closeBody(res.Body)
+
+	if err != nil {
+		return err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(data))
+	c.responseHook(req, res, data)
+
+	return nil
+}
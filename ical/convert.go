@@ -0,0 +1,126 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// Domain is the right-hand side of the UIDs of the events.
+const Domain = "api.intra.42.fr"
+
+// EventUID returns the UID of the entry of an event.
+func EventUID(id fortytwo.EventID) string {
+	return uid("event", id.String())
+}
+
+// ExamUID returns the UID of the entry of an exam.
+func ExamUID(id fortytwo.ExamID) string {
+	return uid("exam", id.String())
+}
+
+// SlotUID returns the UID of the entry of a slot window, identified by its first slot.
+func SlotUID(id fortytwo.SlotID) string {
+	return uid("slot", id.String())
+}
+
+func uid(kind, id string) string {
+	return kind + "-" + id + "@" + Domain
+}
+
+// FromEvent returns the entry of an event.
+func FromEvent(e *fortytwo.Event) Event {
+	return Event{
+		UID:         EventUID(e.ID),
+		Summary:     e.Name,
+		Description: e.Description,
+		Location:    e.Location,
+		Categories:  []string{e.Kind.String()},
+		Start:       e.BeginAt,
+		End:         e.EndAt,
+		Created:     e.CreatedAt,
+		Modified:    e.UpdatedAt,
+		Sequence:    sequence(e.CreatedAt, e.UpdatedAt),
+	}
+}
+
+// FromExam returns the entry of an exam, its description lists the projects of the exam.
+func FromExam(e *fortytwo.Exam) Event {
+	var description string
+
+	if len(e.Projects) > 0 {
+		names := make([]string, len(e.Projects))
+		for i, p := range e.Projects {
+			names[i] = p.Name
+		}
+
+		description = "Projects: " + strings.Join(names, ", ")
+	}
+
+	return Event{
+		UID:         ExamUID(e.ID),
+		Summary:     e.Name,
+		Description: description,
+		Location:    e.Location,
+		Categories:  []string{"exam"},
+		Start:       e.BeginAt,
+		End:         e.EndAt,
+		Created:     e.CreatedAt,
+		Modified:    e.UpdatedAt,
+		Sequence:    sequence(e.CreatedAt, e.UpdatedAt),
+	}
+}
+
+// FromSlotWindow returns the entry of a window of evaluation slots, see fortytwo.MergeSlots.
+// The free windows are transparent, they do not make the corrector busy.
+//
+// The slots have no update date, the sequence of a free window is 0: Handler increases the
+// sequence of the entries whose content changed since they were last served.
+func FromSlotWindow(w *fortytwo.SlotWindow) Event {
+	e := Event{
+		UID:         SlotUID(w.Slots[0]),
+		Summary:     "Available for evaluations",
+		Categories:  []string{"slot"},
+		Start:       w.BeginAt,
+		End:         w.EndAt,
+		Transparent: true,
+	}
+
+	if st := w.ScaleTeam; st != nil {
+		e.Summary = "Evaluation"
+		if st.Team != nil {
+			e.Summary = fmt.Sprintf("Evaluation of %s", st.Team.Name)
+		}
+
+		e.Categories = []string{"evaluation"}
+		e.Created = st.CreatedAt
+		e.Modified = st.UpdatedAt
+		e.Sequence = sequence(st.CreatedAt, st.UpdatedAt)
+		e.Transparent = false
+	}
+
+	return e
+}
+
+// FromSlots returns the entries of the windows of slots.
+func FromSlots(slots []fortytwo.Slot) []Event {
+	windows := fortytwo.MergeSlots(slots)
+
+	events := make([]Event, len(windows))
+	for i := range windows {
+		events[i] = FromSlotWindow(&windows[i])
+	}
+
+	return events
+}
+
+// sequence derives the SEQUENCE of an entry from its dates, so that it increases with every update of the API.
+func sequence(created, updated time.Time) int {
+	if !updated.After(created) {
+		return 0
+	}
+
+	return int(updated.Sub(created) / time.Second)
+}
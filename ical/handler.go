@@ -0,0 +1,326 @@
+package ical
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/auth"
+)
+
+// pageSize is the maximum page size accepted by the 42 API.
+const pageSize = 100
+
+const (
+	defaultPast  = 30 * 24 * time.Hour
+	defaultAhead = 90 * 24 * time.Hour
+)
+
+// minKeyLength is the minimum length of the key of a Handler in bytes.
+const minKeyLength = 32
+
+// profileRegexp matches the profile names accepted by the auth package.
+var profileRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// FeedToken returns the secret of the feed of a profile, the hex HMAC-SHA256 of the profile
+// under key.
+func FeedToken(key []byte, profile string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(profile))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// FeedPath returns the path of the feed of a profile relative to the prefix of a Handler,
+// <profile>/<token>.ics.
+func FeedPath(key []byte, profile string) string {
+	return profile + "/" + FeedToken(key, profile) + ".ics"
+}
+
+// UserCalendar returns the calendar of the owner of a token: the events and the exams they
+// subscribed to and their evaluation slots beginning between from and to, in the time zone of
+// their primary campus.
+func UserCalendar(ctx context.Context, c *fortytwo.Client, tok string, from, to time.Time) (*Calendar, error) {
+	me, err := c.User.Me(ctx, tok)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := &Calendar{Name: "42 " + me.Login, Location: time.UTC}
+
+	if campus := me.PrimaryCampus(); campus != nil && campus.TimeZone != "" {
+		loc, err := time.LoadLocation(campus.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+
+		cal.Location = loc
+	}
+
+	params := &fortytwo.Params{
+		Range: map[string][2]string{"begin_at": {from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339)}},
+		Sort:  []string{"begin_at", "id"},
+	}
+
+	for page := 1; ; page++ {
+		events, _, err := c.Event.FindByUser(ctx, fortytwo.UserID(me.ID), &fortytwo.EventQueryRequest{
+			Pagination: &fortytwo.Pagination{Cursor: page, PageSize: pageSize},
+			Params:     params,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range *events {
+			cal.Add(FromEvent(&(*events)[i]))
+		}
+
+		if len(*events) < pageSize {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		exams, _, err := c.Exam.FindByUser(ctx, fortytwo.UserID(me.ID), &fortytwo.ExamQueryRequest{
+			Pagination: &fortytwo.Pagination{Cursor: page, PageSize: pageSize},
+			Params:     params,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range *exams {
+			cal.Add(FromExam(&(*exams)[i]))
+		}
+
+		if len(*exams) < pageSize {
+			break
+		}
+	}
+
+	var slots []fortytwo.Slot
+
+	for page := 1; ; page++ {
+		found, _, err := c.Slot.Me(ctx, tok, &fortytwo.SlotQueryRequest{
+			Pagination: &fortytwo.Pagination{Cursor: page, PageSize: pageSize},
+			Params:     params,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, *found...)
+
+		if len(*found) < pageSize {
+			break
+		}
+	}
+
+	cal.Add(FromSlots(slots)...)
+
+	return cal, nil
+}
+
+// Handler serves the calendar of the user of a token stored in a FileStore, at
+// <profile>/<token>.ics, see FeedPath. The token is unguessable without the Key, so that the
+// URL of a feed can be given to a calendar app without exposing the other profiles. Unknown
+// profiles and invalid tokens are not found.
+//
+// The entries served to a profile and missing from the next feed, e.g. an event the user
+// unsubscribed from or a deleted slot, are served cancelled until they end, for the apps that
+// keep the entries removed from a feed.
+//
+// The sequence of an entry never decreases across the feeds served to a profile: it is
+// increased whenever the content of the entry changed, e.g. a free slot window shrunk or
+// booked, so that the apps apply the change.
+type Handler struct {
+	Client *fortytwo.Client
+	Store  *auth.FileStore
+	// Key signs the feed tokens, it must be secret and at least 32 random bytes long, the
+	// requests fail with 500 Internal Server Error otherwise
+	Key []byte
+	// Past and Ahead bound the beginning of the entries around now, 30 and 90 days by default
+	Past  time.Duration
+	Ahead time.Duration
+	// ErrorLog logs the errors of the API, the log package by default
+	ErrorLog *log.Logger
+
+	mu     sync.Mutex
+	served map[string]map[string]Event
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if len(h.Key) < minKeyLength {
+		h.logf("ical: feed key shorter than %d bytes", minKeyLength)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	profile, ok := h.profile(r)
+	if !ok {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	tok, err := auth.Token(r.Context(), h.Client, h.Store, profile)
+	if errors.Is(err, auth.ErrNotLoggedIn) {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	if err != nil {
+		h.logf("ical: token of profile %s: %s", profile, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	past, ahead := h.Past, h.Ahead
+	if past == 0 {
+		past = defaultPast
+	}
+
+	if ahead == 0 {
+		ahead = defaultAhead
+	}
+
+	now := time.Now()
+
+	cal, err := UserCalendar(r.Context(), h.Client, tok.AccessToken, now.Add(-past), now.Add(ahead))
+	if err != nil {
+		h.logf("ical: calendar of profile %s: %s", profile, err)
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+
+		return
+	}
+
+	cal.Stamp = now
+	cal.Add(h.track(profile, cal.Events, now)...)
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, max-age=300")
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	if _, err := cal.WriteTo(w); err != nil {
+		h.logf("ical: write calendar of profile %s: %s", profile, err)
+	}
+}
+
+// track records the entries served to a profile, raises the sequence of the events whose content
+// changed since they were last served and returns the cancelled versions of the entries
+// previously served and missing from events, until they end.
+func (h *Handler) track(profile string, events []Event, now time.Time) []Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.served == nil {
+		h.served = map[string]map[string]Event{}
+	}
+
+	previous := h.served[profile]
+	current := map[string]Event{}
+
+	for i := range events {
+		e := &events[i]
+
+		if p, ok := previous[e.UID]; ok {
+			switch {
+			case !sameContent(e, &p) && e.Sequence <= p.Sequence:
+				e.Sequence = p.Sequence + 1
+			case e.Sequence < p.Sequence:
+				e.Sequence = p.Sequence
+			}
+		}
+
+		current[e.UID] = *e
+	}
+
+	var cancelled []Event
+
+	for id, e := range previous {
+		if _, ok := current[id]; ok || !e.End.After(now) {
+			continue
+		}
+
+		if e.Status != StatusCancelled {
+			e = e.Cancel()
+		}
+
+		cancelled = append(cancelled, e)
+		current[id] = e
+	}
+
+	h.served[profile] = current
+
+	return cancelled
+}
+
+// sameContent reports whether two versions of an entry differ only by their sequence.
+func sameContent(a, b *Event) bool {
+	if len(a.Categories) != len(b.Categories) {
+		return false
+	}
+
+	for i := range a.Categories {
+		if a.Categories[i] != b.Categories[i] {
+			return false
+		}
+	}
+
+	return a.UID == b.UID && a.Summary == b.Summary && a.Description == b.Description &&
+		a.Location == b.Location && a.Start.Equal(b.Start) && a.End.Equal(b.End) &&
+		a.Created.Equal(b.Created) && a.Modified.Equal(b.Modified) && a.Status == b.Status &&
+		a.Transparent == b.Transparent
+}
+
+// profile returns the profile of a request whose path ends with a valid feed path.
+func (h *Handler) profile(r *http.Request) (string, bool) {
+	dir, file := path.Split(r.URL.Path)
+	profile := path.Base(dir)
+
+	token := strings.TrimSuffix(file, ".ics")
+	if token == file || !profileRegexp.MatchString(profile) {
+		return "", false
+	}
+
+	want := FeedToken(h.Key, profile)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(want)) != 1 {
+		return "", false
+	}
+
+	return profile, true
+}
+
+func (h *Handler) logf(format string, args ...interface{}) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf(format, args...)
+
+		return
+	}
+
+	log.Printf(format, args...)
+}
@@ -0,0 +1,342 @@
+// Package ical converts the events, exams and evaluation slots of the 42 API into RFC 5545
+// iCalendar feeds that calendar apps can subscribe to.
+//
+// The UIDs are derived from the IDs of the API so that the apps update the entries of a feed
+// instead of duplicating them, and the dates are written in the time zone of the campus.
+//
+//	cal := &ical.Calendar{Name: "42", Location: loc}
+//	for i := range *events {
+//		cal.Add(ical.FromEvent(&(*events)[i]))
+//	}
+//
+//	cal.WriteTo(w)
+//
+// Handler serves the feed of the user of a token stored by the auth package, at an URL signed
+// with a secret key.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ProductID is the PRODID of the calendars.
+const ProductID = "-//naofel1//go-fortytwo//EN"
+
+// maxLineLength is the maximum length of a content line in octets, without the CRLF.
+const maxLineLength = 75
+
+const (
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+)
+
+// Status defines the type for the status of an Event.
+type Status string
+
+// Status values.
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusTentative Status = "TENTATIVE"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// String returns the string value for Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// Event is a VEVENT of a calendar.
+type Event struct {
+	// UID identifies the event across the versions of the feed
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Categories  []string
+	Start       time.Time
+	End         time.Time
+	Created     time.Time
+	Modified    time.Time
+	// Sequence is the revision of the event, the apps apply the changes of a greater sequence only
+	Sequence int
+	// Status defaults to StatusConfirmed
+	Status Status
+	// Transparent events do not make the user busy, e.g. free evaluation slots
+	Transparent bool
+}
+
+// Cancel returns a copy of the event cancelled, with the next sequence.
+func (e Event) Cancel() Event {
+	e.Status = StatusCancelled
+	e.Sequence++
+
+	return e
+}
+
+// Calendar is a VCALENDAR.
+type Calendar struct {
+	// Name is the display name of the calendar in the apps
+	Name string
+	// Location is the time zone of the dates of the events, UTC by default
+	Location *time.Location
+	// Stamp is the DTSTAMP of the events, time.Now by default
+	Stamp  time.Time
+	Events []Event
+}
+
+// Add appends events to the calendar.
+func (c *Calendar) Add(events ...Event) {
+	c.Events = append(c.Events, events...)
+}
+
+// WriteTo writes the calendar to w, the events sorted by start date.
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	stamp := c.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	events := make([]Event, len(c.Events))
+	copy(events, c.Events)
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+
+	b := &writer{}
+
+	b.line("BEGIN:VCALENDAR")
+	b.line("VERSION:2.0")
+	b.line("PRODID:" + ProductID)
+	b.line("CALSCALE:GREGORIAN")
+	b.line("METHOD:PUBLISH")
+
+	if c.Name != "" {
+		b.line("X-WR-CALNAME:" + escape(c.Name))
+	}
+
+	if loc != time.UTC {
+		b.line("X-WR-TIMEZONE:" + loc.String())
+
+		if len(events) > 0 {
+			writeTimezone(b, loc, events[0].Start, latestEnd(events))
+		}
+	}
+
+	for i := range events {
+		writeEvent(b, &events[i], loc, stamp)
+	}
+
+	b.line("END:VCALENDAR")
+
+	n, err := w.Write(b.buf.Bytes())
+
+	return int64(n), err
+}
+
+func writeEvent(b *writer, e *Event, loc *time.Location, stamp time.Time) {
+	status := e.Status
+	if status == "" {
+		status = StatusConfirmed
+	}
+
+	b.line("BEGIN:VEVENT")
+	b.line("UID:" + escape(e.UID))
+	b.line("DTSTAMP:" + formatUTC(stamp))
+	b.line(dateProperty("DTSTART", e.Start, loc))
+	b.line(dateProperty("DTEND", e.End, loc))
+
+	if !e.Created.IsZero() {
+		b.line("CREATED:" + formatUTC(e.Created))
+	}
+
+	if !e.Modified.IsZero() {
+		b.line("LAST-MODIFIED:" + formatUTC(e.Modified))
+	}
+
+	b.line(fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+	b.line("STATUS:" + status.String())
+	b.line("SUMMARY:" + escape(e.Summary))
+
+	if e.Description != "" {
+		b.line("DESCRIPTION:" + escape(e.Description))
+	}
+
+	if e.Location != "" {
+		b.line("LOCATION:" + escape(e.Location))
+	}
+
+	if len(e.Categories) > 0 {
+		categories := make([]string, len(e.Categories))
+		for i, c := range e.Categories {
+			categories[i] = escape(c)
+		}
+
+		b.line("CATEGORIES:" + strings.Join(categories, ","))
+	}
+
+	if e.Transparent {
+		b.line("TRANSP:TRANSPARENT")
+	} else {
+		b.line("TRANSP:OPAQUE")
+	}
+
+	b.line("END:VEVENT")
+}
+
+// writeTimezone writes the VTIMEZONE of loc with its transitions between the beginning of the
+// year of from and the end of the year of to.
+func writeTimezone(b *writer, loc *time.Location, from, to time.Time) {
+	begin := time.Date(from.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(to.In(loc).Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	b.line("BEGIN:VTIMEZONE")
+	b.line("TZID:" + loc.String())
+
+	// The first observance covers the dates before the first transition of the range
+	name, offset := begin.Zone()
+	writeObservance(b, begin.IsDST(), begin.UTC().Add(time.Duration(offset)*time.Second), offset, offset, name)
+
+	for t := begin; t.Before(end); {
+		next := t.AddDate(0, 0, 1)
+
+		if _, o := next.Zone(); o != offset {
+			at := transition(t, next)
+			name, o := at.Zone()
+
+			writeObservance(b, at.IsDST(), at.UTC().Add(time.Duration(offset)*time.Second), offset, o, name)
+			offset = o
+		}
+
+		t = next
+	}
+
+	b.line("END:VTIMEZONE")
+}
+
+// transition returns the first instant of (from, to] whose offset differs from the one of from.
+func transition(from, to time.Time) time.Time {
+	_, offset := from.Zone()
+
+	for to.Sub(from) > time.Second {
+		mid := from.Add(to.Sub(from) / 2)
+
+		if _, o := mid.Zone(); o == offset {
+			from = mid
+		} else {
+			to = mid
+		}
+	}
+
+	return to
+}
+
+// writeObservance writes a STANDARD or DAYLIGHT component beginning at onset, the local time of
+// the transition in the previous offset.
+func writeObservance(b *writer, dst bool, onset time.Time, from, to int, name string) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+
+	b.line("BEGIN:" + kind)
+	b.line("DTSTART:" + onset.Format(dateTimeLayout))
+	b.line("TZOFFSETFROM:" + formatOffset(from))
+	b.line("TZOFFSETTO:" + formatOffset(to))
+	b.line("TZNAME:" + escape(name))
+	b.line("END:" + kind)
+}
+
+func latestEnd(events []Event) time.Time {
+	end := events[0].End
+
+	for _, e := range events {
+		if e.End.After(end) {
+			end = e.End
+		}
+
+		if e.Start.After(end) {
+			end = e.Start
+		}
+	}
+
+	return end
+}
+
+func dateProperty(name string, t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return name + ":" + formatUTC(t)
+	}
+
+	return name + ";TZID=" + loc.String() + ":" + t.In(loc).Format(dateTimeLayout)
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format(utcDateTimeLayout)
+}
+
+// formatOffset formats an offset in seconds as +HHMM, or +HHMMSS when it has seconds.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	s := fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+
+	return s
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// writer writes the content lines of a calendar, folded at maxLineLength octets.
+type writer struct {
+	buf bytes.Buffer
+}
+
+func (w *writer) line(s string) {
+	limit := maxLineLength
+
+	for len(s) > limit {
+		// Never split a UTF-8 sequence
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+
+		// Invalid UTF-8 without a rune start is split at the limit
+		if cut == 0 {
+			cut = limit
+		}
+
+		w.buf.WriteString(s[:cut])
+		w.buf.WriteString("\r\n ")
+		s = s[cut:]
+
+		// The leading space of the continuation lines counts in their length
+		limit = maxLineLength - 1
+	}
+
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
@@ -1,13 +1,51 @@
 package fortytwo
 
+import "encoding/json"
+
 type ImageVersion struct {
 	Large  string `json:"large"`
 	Medium string `json:"medium"`
 	Small  string `json:"small"`
 	Micro  string `json:"micro"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (i *ImageVersion) UnmarshalJSON(data []byte) error {
+	type imageVersion ImageVersion
+
+	extra, err := unmarshalExtra(data, (*imageVersion)(i))
+	i.Extra = extra
+
+	return err
+}
+
+func (i ImageVersion) MarshalJSON() ([]byte, error) {
+	type imageVersion ImageVersion
+
+	return marshalExtra(imageVersion(i), i.Extra)
 }
 
 type Image struct {
 	Link     string        `json:"link"`
 	Versions *ImageVersion `json:"versions"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (i *Image) UnmarshalJSON(data []byte) error {
+	type image Image
+
+	extra, err := unmarshalExtra(data, (*image)(i))
+	i.Extra = extra
+
+	return err
+}
+
+func (i Image) MarshalJSON() ([]byte, error) {
+	type image Image
+
+	return marshalExtra(image(i), i.Extra)
 }
@@ -0,0 +1,113 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type LanguageID int
+
+func (pID LanguageID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type LanguageService interface {
+	List(context.Context, *LanguageQueryRequest) (*Languages, *PaginationResponse, error)
+	FindByID(context.Context, LanguageID) (*Language, error)
+	Create(context.Context, LanguageAttributes) (*Language, error)
+	Update(context.Context, LanguageID, LanguageAttributes) error
+	DeleteByID(context.Context, LanguageID) error
+}
+
+// Not generated:
+//   - GET /languages/graph
+//   - GET /languages/graph/on/{field}
+//   - GET /languages/graph/on/{field}/by/{interval}
+
+type LanguageClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/languages/index.html
+func (l *LanguageClient) List(ctx context.Context, req *LanguageQueryRequest) (*Languages, *PaginationResponse, error) {
+	res, err := l.apiClient.request(ctx, http.MethodGet, "languages", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLanguagesPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/languages/show.html
+func (l *LanguageClient) FindByID(ctx context.Context, id LanguageID) (*Language, error) {
+	res, err := l.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("languages/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLanguageResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/languages/create.html
+func (l *LanguageClient) Create(ctx context.Context, attrs LanguageAttributes) (*Language, error) {
+	res, err := l.apiClient.request(ctx, http.MethodPost, "languages", "", nil, map[string]LanguageAttributes{"language": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLanguageResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/languages/update.html
+func (l *LanguageClient) Update(ctx context.Context, id LanguageID, attrs LanguageAttributes) error {
+	res, err := l.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("languages/%s", id.String()), "", nil, map[string]LanguageAttributes{"language": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/languages/destroy.html
+func (l *LanguageClient) DeleteByID(ctx context.Context, id LanguageID) error {
+	res, err := l.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("languages/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+func handleLanguageResponse(res *http.Response) (*Language, error) {
+	var response Language
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleLanguagesPaginatedResponse(res *http.Response) (*Languages, *PaginationResponse, error) {
+	var response Languages
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
@@ -0,0 +1,39 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+import "encoding/json"
+
+type Languages []Language
+
+type Language struct {
+	ID         int    `json:"id"`
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (l *Language) UnmarshalJSON(data []byte) error {
+	type language Language
+
+	extra, err := unmarshalExtra(data, (*language)(l))
+	l.Extra = extra
+
+	return err
+}
+
+func (l Language) MarshalJSON() ([]byte, error) {
+	type language Language
+
+	return marshalExtra(language(l), l.Extra)
+}
+
+type LanguageQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
+
+// LanguageAttributes are the attributes of the create and update requests, keyed by their API name.
+type LanguageAttributes map[string]interface{}
@@ -1,6 +1,9 @@
 package fortytwo
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type LanguageUserID int
 
@@ -12,4 +15,22 @@ type LanguageUser struct {
 	UserID     int       `json:"user_id"`
 	Position   int       `json:"position"`
 	CreatedAt  time.Time `json:"created_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (l *LanguageUser) UnmarshalJSON(data []byte) error {
+	type languageUser LanguageUser
+
+	extra, err := unmarshalExtra(data, (*languageUser)(l))
+	l.Extra = extra
+
+	return err
+}
+
+func (l LanguageUser) MarshalJSON() ([]byte, error) {
+	type languageUser LanguageUser
+
+	return marshalExtra(languageUser(l), l.Extra)
 }
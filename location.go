@@ -0,0 +1,127 @@
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type LocationService interface {
+	List(context.Context, *LocationQueryRequest) (*Locations, *PaginationResponse, error)
+	FindByID(context.Context, LocationID) (*Location, error)
+	FindByCampus(context.Context, CampusID, *LocationQueryRequest) (*Locations, *PaginationResponse, error)
+	FindByUser(context.Context, UserID, *LocationQueryRequest) (*Locations, *PaginationResponse, error)
+
+	// Active lists the users currently logged on the hosts of a campus
+	Active(context.Context, CampusID, *LocationQueryRequest) (*Locations, *PaginationResponse, error)
+
+	Stats(context.Context, UserID, *LocationStatsRequest) (*LocationStats, error)
+}
+
+type LocationClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/locations/index.html
+func (a *LocationClient) List(ctx context.Context, req *LocationQueryRequest) (*Locations, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "locations", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLocationsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/locations/show.html
+func (a *LocationClient) FindByID(ctx context.Context, id LocationID) (*Location, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("locations/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLocationResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/locations/index.html
+func (a *LocationClient) FindByCampus(ctx context.Context, id CampusID, req *LocationQueryRequest) (*Locations, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("campus/%s/locations", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLocationsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/locations/index.html
+func (a *LocationClient) FindByUser(ctx context.Context, id UserID, req *LocationQueryRequest) (*Locations, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/locations", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLocationsPaginatedResponse(res)
+}
+
+// Active is FindByCampus with filter[active]=true.
+//
+// Get https://api.intra.42.fr/apidoc/2.0/locations/index.html
+func (a *LocationClient) Active(ctx context.Context, id CampusID, req *LocationQueryRequest) (*Locations, *PaginationResponse, error) {
+	active := &LocationQueryRequest{Params: &Params{Filter: map[string][]string{"active": {"true"}}}}
+
+	if req != nil {
+		active.Pagination = req.Pagination
+		active.Params = req.Params.Merge(active.Params)
+	}
+
+	return a.FindByCampus(ctx, id, active)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/users/locations_stats.html
+func (a *LocationClient) Stats(ctx context.Context, id UserID, req *LocationStatsRequest) (*LocationStats, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/locations_stats", id.String()), "", req.ToQuery(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleLocationStatsResponse(res)
+}
+
+func handleLocationResponse(res *http.Response) (*Location, error) {
+	var response Location
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleLocationsPaginatedResponse(res *http.Response) (*Locations, *PaginationResponse, error) {
+	var response Locations
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
+
+func handleLocationStatsResponse(res *http.Response) (*LocationStats, error) {
+	var response LocationStats
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
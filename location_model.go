@@ -0,0 +1,201 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type LocationID int
+
+func (pID LocationID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type Locations []Location
+
+// Location is a session of a user on a host of a campus.
+type Location struct {
+	ID       LocationID  `json:"id"`
+	BeginAt  time.Time   `json:"begin_at"`
+	EndAt    *time.Time  `json:"end_at"`
+	Primary  bool        `json:"primary"`
+	Host     string      `json:"host"`
+	Floor    *string     `json:"floor"`
+	Row      *string     `json:"row"`
+	Post     *string     `json:"post"`
+	CampusID CampusID    `json:"campus_id"`
+	User     UserSummary `json:"user"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (l *Location) UnmarshalJSON(data []byte) error {
+	type location Location
+
+	extra, err := unmarshalExtra(data, (*location)(l))
+	l.Extra = extra
+
+	return err
+}
+
+func (l Location) MarshalJSON() ([]byte, error) {
+	type location Location
+
+	return marshalExtra(location(l), l.Extra)
+}
+
+// Active reports whether the user is still logged on the host.
+func (l *Location) Active() bool {
+	return l.EndAt == nil
+}
+
+// Duration returns the duration of the session, up to now when it is still active.
+func (l *Location) Duration(now time.Time) time.Duration {
+	if l.EndAt != nil {
+		return l.EndAt.Sub(l.BeginAt)
+	}
+
+	return now.Sub(l.BeginAt)
+}
+
+// LocationStatsDateLayout is the layout of the days of LocationStats.
+const LocationStatsDateLayout = "2006-01-02"
+
+// LocationStats is the logged time of a user per day, keyed with LocationStatsDateLayout.
+type LocationStats map[string]time.Duration
+
+// LocationsStat is the former name of LocationStats.
+//
+// Deprecated: use LocationStats. The values changed from the raw "HH:MM:SS.ffffff" strings of the
+// API to time.Duration, the code reading them must be updated too.
+type LocationsStat = LocationStats
+
+func (s *LocationStats) UnmarshalJSON(data []byte) error {
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	stats := make(LocationStats, len(raw))
+
+	for day, value := range raw {
+		d, err := parseClockDuration(value)
+		if err != nil {
+			return fmt.Errorf("locations stats of %s: %w", day, err)
+		}
+
+		stats[day] = d
+	}
+
+	*s = stats
+
+	return nil
+}
+
+func (s LocationStats) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]string, len(s))
+
+	for day, d := range s {
+		raw[day] = formatClockDuration(d)
+	}
+
+	return json.Marshal(raw)
+}
+
+// Days returns the days of the stats in chronological order.
+func (s LocationStats) Days() []string {
+	days := make([]string, 0, len(s))
+	for day := range s {
+		days = append(days, day)
+	}
+
+	sort.Strings(days)
+
+	return days
+}
+
+// Total returns the logged time of all the days.
+func (s LocationStats) Total() time.Duration {
+	var total time.Duration
+	for _, d := range s {
+		total += d
+	}
+
+	return total
+}
+
+// parseClockDuration parses the HH:MM:SS.ffffff durations of the locations stats.
+func parseClockDuration(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	seconds, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(math.Round(seconds*float64(time.Second))), nil
+}
+
+func formatClockDuration(d time.Duration) string {
+	hours := d / time.Hour
+	d -= hours * time.Hour
+
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+
+	return fmt.Sprintf("%02d:%02d:%09.6f", hours, minutes, d.Seconds())
+}
+
+// LocationStatsRequest are the optional bounds of the locations stats, the API defaults to the
+// time zone of the user.
+type LocationStatsRequest struct {
+	BeginAt  time.Time
+	EndAt    time.Time
+	TimeZone string
+}
+
+func (r *LocationStatsRequest) ToQuery() map[string]string {
+	if r == nil {
+		return nil
+	}
+
+	q := map[string]string{}
+
+	if !r.BeginAt.IsZero() {
+		q["begin_at"] = r.BeginAt.Format(LocationStatsDateLayout)
+	}
+
+	if !r.EndAt.IsZero() {
+		q["end_at"] = r.EndAt.Format(LocationStatsDateLayout)
+	}
+
+	if r.TimeZone != "" {
+		q["time_zone"] = r.TimeZone
+	}
+
+	return q
+}
+
+type LocationQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
@@ -0,0 +1,47 @@
+package logtime
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// csvHeader are the columns of WriteCSV.
+var csvHeader = []string{"user_id", "login", "period", "seconds", "hours"}
+
+// Rows returns a row per user and period, with the columns of WriteCSV.
+func (r *Report) Rows() [][]string {
+	rows := make([][]string, 0, len(r.Users)*len(r.Periods))
+
+	for _, u := range r.Users {
+		for i, start := range r.Periods {
+			d := u.Durations[i]
+
+			rows = append(rows, []string{
+				strconv.Itoa(u.UserID),
+				u.Login,
+				start.Format("2006-01-02"),
+				strconv.FormatInt(int64(d/time.Second), 10),
+				strconv.FormatFloat(d.Hours(), 'f', 2, 64),
+			})
+		}
+	}
+
+	return rows
+}
+
+// WriteCSV writes the report as CSV, with a header and a row per user and period.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	if err := cw.WriteAll(r.Rows()); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
@@ -0,0 +1,162 @@
+package logtime
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// pageSize is the maximum page size accepted by the 42 API.
+const pageSize = 100
+
+// The API ranges need two bounds, these stand for the open ones.
+var (
+	minBound = time.Unix(0, 0).UTC()
+	maxBound = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// ForUser returns the report of the locations of a user.
+func ForUser(ctx context.Context, c *fortytwo.Client, id fortytwo.UserID, opts Options) (*Report, error) {
+	locations, err := fetch(ctx, opts, nil, func(page *fortytwo.Pagination, params *fortytwo.Params) (*fortytwo.Locations, error) {
+		locations, _, err := c.Location.FindByUser(ctx, id, &fortytwo.LocationQueryRequest{Pagination: page, Params: params})
+
+		return locations, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return Compute(locations, opts), nil
+}
+
+// ForCampus returns the report of the locations of all the users of a campus.
+func ForCampus(ctx context.Context, c *fortytwo.Client, id fortytwo.CampusID, opts Options) (*Report, error) {
+	locations, err := fetch(ctx, opts, nil, func(page *fortytwo.Pagination, params *fortytwo.Params) (*fortytwo.Locations, error) {
+		locations, _, err := c.Location.FindByCampus(ctx, id, &fortytwo.LocationQueryRequest{Pagination: page, Params: params})
+
+		return locations, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return Compute(locations, opts), nil
+}
+
+// ForPool returns the report of the users of a pool of a campus, e.g. "2023" and "july".
+func ForPool(ctx context.Context, c *fortytwo.Client, id fortytwo.CampusID, year, month string, opts Options) (*Report, error) {
+	var ids []string
+
+	filter := &fortytwo.Params{Filter: map[string][]string{
+		"primary_campus_id": {id.String()},
+		"pool_year":         {year},
+		"pool_month":        {month},
+	}}
+
+	for page := 1; ; page++ {
+		users, _, err := c.User.List(ctx, &fortytwo.CursusQueryRequest{
+			Pagination: &fortytwo.Pagination{Cursor: page, PageSize: pageSize},
+			Params:     filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range *users {
+			ids = append(ids, strconv.Itoa(u.ID))
+		}
+
+		if len(*users) < pageSize {
+			break
+		}
+	}
+
+	var locations []fortytwo.Location
+
+	// The user ids go in the query string, a chunk of them per walk keeps it short
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > pageSize {
+			n = pageSize
+		}
+
+		chunk := &fortytwo.Params{Filter: map[string][]string{"user_id": ids[:n]}}
+		ids = ids[n:]
+
+		found, err := fetch(ctx, opts, chunk, func(page *fortytwo.Pagination, params *fortytwo.Params) (*fortytwo.Locations, error) {
+			locations, _, err := c.Location.List(ctx, &fortytwo.LocationQueryRequest{Pagination: page, Params: params})
+
+			return locations, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, found...)
+	}
+
+	return Compute(locations, opts), nil
+}
+
+// fetch returns the locations overlapping the bounds of the options: the sessions begun before
+// opts.To and either ended after opts.From or still open, whatever their length.
+func fetch(ctx context.Context, opts Options, params *fortytwo.Params, list func(*fortytwo.Pagination, *fortytwo.Params) (*fortytwo.Locations, error)) ([]fortytwo.Location, error) {
+	opts = opts.withDefaults()
+
+	begun := &fortytwo.Params{
+		Range: map[string][2]string{"begin_at": {formatBound(minBound), formatBound(opts.To)}},
+		Sort:  []string{"begin_at", "id"},
+	}
+
+	if opts.From.IsZero() {
+		return walk(ctx, params.Merge(begun), list)
+	}
+
+	// The open sessions have no end_at, the range on end_at leaves them out
+	ended := params.Merge(begun).Merge(&fortytwo.Params{
+		Range: map[string][2]string{"end_at": {formatBound(opts.From), formatBound(maxBound)}},
+	})
+
+	locations, err := walk(ctx, ended, list)
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := walk(ctx, params.Merge(begun).Merge(&fortytwo.Params{
+		Filter: map[string][]string{"active": {"true"}},
+	}), list)
+	if err != nil {
+		return nil, err
+	}
+
+	// A session closed between the two walks may be in both, Compute counts the overlaps once
+	return append(locations, open...), nil
+}
+
+// walk returns the locations of every page of a query.
+func walk(ctx context.Context, params *fortytwo.Params, list func(*fortytwo.Pagination, *fortytwo.Params) (*fortytwo.Locations, error)) ([]fortytwo.Location, error) {
+	var all []fortytwo.Location
+
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		locations, err := list(&fortytwo.Pagination{Cursor: page, PageSize: pageSize}, params)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, *locations...)
+
+		if len(*locations) < pageSize {
+			return all, nil
+		}
+	}
+}
+
+func formatBound(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,323 @@
+// Package logtime computes the logged time of users per day, week or month from their locations,
+// in the time zone of their campus.
+//
+//	loc, err := logtime.CampusLocation(campus)
+//	if err != nil {
+//		return err
+//	}
+//
+//	report, err := logtime.ForUser(ctx, client, 42, logtime.Options{
+//		Period:   logtime.Week,
+//		Location: loc,
+//		From:     time.Now().AddDate(0, -1, 0),
+//	})
+//	if err != nil {
+//		return err
+//	}
+//
+//	report.WriteCSV(os.Stdout)
+package logtime
+
+import (
+	"sort"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// Period defines the type for the length of the periods of a report.
+type Period string
+
+// Period values.
+const (
+	Day   Period = "day"
+	Week  Period = "week"
+	Month Period = "month"
+)
+
+// String returns the string value for Period.
+func (p Period) String() string {
+	return string(p)
+}
+
+// Start returns the beginning of the period holding t in loc, weeks begin on Monday.
+func (p Period) Start(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+
+	switch p {
+	case Week:
+		offset := (int(t.Weekday()) + 6) % 7
+
+		return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, loc)
+	case Month:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+}
+
+// Next returns the beginning of the period following the one beginning at start.
+func (p Period) Next(start time.Time) time.Time {
+	switch p {
+	case Week:
+		return start.AddDate(0, 0, 7)
+	case Month:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Options configure the computation of a report.
+type Options struct {
+	// Period defaults to Day
+	Period Period
+	// Location is the time zone of the periods, UTC by default
+	Location *time.Location
+	// From and To bound the report, the zero values select all the sessions and now
+	From time.Time
+	To   time.Time
+	// Now ends the sessions still open, time.Now by default
+	Now time.Time
+}
+
+func (o Options) withDefaults() Options {
+	if o.Period == "" {
+		o.Period = Day
+	}
+
+	if o.Location == nil {
+		o.Location = time.UTC
+	}
+
+	if o.Now.IsZero() {
+		o.Now = time.Now()
+	}
+
+	if o.To.IsZero() || o.To.After(o.Now) {
+		o.To = o.Now
+	}
+
+	return o
+}
+
+// UserLogtime is the logged time of a user.
+type UserLogtime struct {
+	UserID int
+	Login  string
+	// Durations are the logged time per period, aligned with Report.Periods
+	Durations []time.Duration
+	Total     time.Duration
+}
+
+// Report is the logged time of users per period.
+type Report struct {
+	Period   Period
+	Location *time.Location
+	// Periods are the beginnings of the periods of the report, in chronological order without gaps
+	Periods []time.Time
+	// Users are sorted by login
+	Users []UserLogtime
+}
+
+// Totals returns the logged time of all the users per period, aligned with Periods.
+func (r *Report) Totals() []time.Duration {
+	totals := make([]time.Duration, len(r.Periods))
+
+	for _, u := range r.Users {
+		for i, d := range u.Durations {
+			totals[i] += d
+		}
+	}
+
+	return totals
+}
+
+// CampusLocation returns the time zone of a campus.
+func CampusLocation(c *fortytwo.Campus) (*time.Location, error) {
+	return time.LoadLocation(c.TimeZone)
+}
+
+type interval struct {
+	begin, end time.Time
+}
+
+type userIntervals struct {
+	id        int
+	login     string
+	intervals []interval
+}
+
+// Compute returns the report of the locations. The sessions are clipped to the bounds of the options,
+// the sessions still open end now, and the overlapping sessions of a user on several hosts are
+// counted once. A session spanning several periods is split at their boundaries.
+func Compute(locations []fortytwo.Location, opts Options) *Report {
+	opts = opts.withDefaults()
+
+	users := map[int]*userIntervals{}
+
+	for i := range locations {
+		l := &locations[i]
+
+		end := opts.Now
+		if l.EndAt != nil {
+			end = *l.EndAt
+		}
+
+		begin := l.BeginAt
+		if begin.Before(opts.From) {
+			begin = opts.From
+		}
+
+		if end.After(opts.To) {
+			end = opts.To
+		}
+
+		u, ok := users[l.User.ID]
+		if !ok {
+			u = &userIntervals{id: l.User.ID, login: l.User.Login}
+			users[l.User.ID] = u
+		}
+
+		if end.After(begin) {
+			u.intervals = append(u.intervals, interval{begin: begin, end: end})
+		}
+	}
+
+	perUser := map[int]map[time.Time]time.Duration{}
+
+	var first time.Time
+
+	for id, u := range users {
+		durations := map[time.Time]time.Duration{}
+
+		for _, iv := range merge(u.intervals) {
+			for start := opts.Period.Start(iv.begin, opts.Location); start.Before(iv.end); start = opts.Period.Next(start) {
+				next := opts.Period.Next(start)
+
+				begin, end := iv.begin, iv.end
+				if begin.Before(start) {
+					begin = start
+				}
+
+				if end.After(next) {
+					end = next
+				}
+
+				durations[start] += end.Sub(begin)
+
+				if first.IsZero() || start.Before(first) {
+					first = start
+				}
+			}
+		}
+
+		perUser[id] = durations
+	}
+
+	if !opts.From.IsZero() {
+		first = opts.Period.Start(opts.From, opts.Location)
+	}
+
+	r := &Report{Period: opts.Period, Location: opts.Location}
+
+	if !first.IsZero() {
+		last := opts.Period.Start(opts.To.Add(-time.Nanosecond), opts.Location)
+
+		for start := first; !start.After(last); start = opts.Period.Next(start) {
+			r.Periods = append(r.Periods, start)
+		}
+	}
+
+	for id, u := range users {
+		ul := UserLogtime{UserID: id, Login: u.login, Durations: make([]time.Duration, len(r.Periods))}
+
+		for i, start := range r.Periods {
+			ul.Durations[i] = perUser[id][start]
+			ul.Total += ul.Durations[i]
+		}
+
+		r.Users = append(r.Users, ul)
+	}
+
+	sortUsers(r.Users)
+
+	return r
+}
+
+// FromStats returns the report of the locations stats of a user, the days of the stats being in
+// the time zone of the options. The stats are already grouped by day, so the sessions still open
+// and the bounds are resolved by the API.
+func FromStats(stats fortytwo.LocationStats, userID int, login string, opts Options) (*Report, error) {
+	opts = opts.withDefaults()
+
+	durations := map[time.Time]time.Duration{}
+
+	var first, last time.Time
+
+	for day, d := range stats {
+		t, err := time.ParseInLocation(fortytwo.LocationStatsDateLayout, day, opts.Location)
+		if err != nil {
+			return nil, err
+		}
+
+		start := opts.Period.Start(t, opts.Location)
+		durations[start] += d
+
+		if first.IsZero() || start.Before(first) {
+			first = start
+		}
+
+		if start.After(last) {
+			last = start
+		}
+	}
+
+	r := &Report{Period: opts.Period, Location: opts.Location}
+	ul := UserLogtime{UserID: userID, Login: login}
+
+	if !first.IsZero() {
+		for start := first; !start.After(last); start = opts.Period.Next(start) {
+			r.Periods = append(r.Periods, start)
+			ul.Durations = append(ul.Durations, durations[start])
+			ul.Total += durations[start]
+		}
+	}
+
+	r.Users = []UserLogtime{ul}
+
+	return r, nil
+}
+
+// merge returns the union of the intervals, sorted.
+func merge(intervals []interval) []interval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].begin.Before(intervals[j].begin)
+	})
+
+	var merged []interval
+
+	for _, iv := range intervals {
+		if n := len(merged); n > 0 && !iv.begin.After(merged[n-1].end) {
+			if iv.end.After(merged[n-1].end) {
+				merged[n-1].end = iv.end
+			}
+
+			continue
+		}
+
+		merged = append(merged, iv)
+	}
+
+	return merged
+}
+
+func sortUsers(users []UserLogtime) {
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Login != users[j].Login {
+			return users[i].Login < users[j].Login
+		}
+
+		return users[i].UserID < users[j].UserID
+	})
+}
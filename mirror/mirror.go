@@ -0,0 +1,246 @@
+// Package mirror keeps a local SQLite copy of selected 42 API resources.
+//
+// The first sync of a resource crawls every record, the next ones only fetch the records
+// updated since the last checkpoint using range[updated_at] and sort=updated_at.
+// Checkpoints are committed along with every page so that an interrupted sync resumes where it stopped.
+//
+// The package only relies on database/sql, register a SQLite driver such as
+// github.com/mattn/go-sqlite3 or modernc.org/sqlite before opening the database.
+package mirror
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/source"
+)
+
+const checkpointTable = "sync_checkpoints"
+
+var tableNameRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// Checkpoint records the progress of the synchronization of a resource.
+type Checkpoint struct {
+	Resource string
+	// Cursor is the updated_at of the most recent record stored, incremental syncs restart from it
+	Cursor time.Time
+	// Page is the next page to fetch for the current cursor, 0 when the last sync completed
+	Page int
+	// CompletedAt is the end of the last complete sync
+	CompletedAt time.Time
+}
+
+// Option to configure the mirror
+type Option func(*Mirror)
+
+// Mirror synchronizes resources into a SQLite database.
+type Mirror struct {
+	db       *sql.DB
+	sources  []source.Source
+	pageSize int
+}
+
+// New returns a mirror storing the given sources into db.
+func New(db *sql.DB, sources []source.Source, opts ...Option) *Mirror {
+	m := &Mirror{
+		db:       db,
+		sources:  sources,
+		pageSize: source.DefaultPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// WithPageSize overrides the number of records fetched per request
+func WithPageSize(size int) Option {
+	return func(m *Mirror) {
+		m.pageSize = size
+	}
+}
+
+// Init creates the tables of the mirror if they do not exist yet.
+func (m *Mirror) Init(ctx context.Context) error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS ` + checkpointTable + ` (
+			resource     TEXT PRIMARY KEY,
+			cursor       TEXT,
+			page         INTEGER NOT NULL DEFAULT 0,
+			completed_at TEXT
+		)`,
+	}
+
+	for _, src := range m.sources {
+		if !tableNameRegexp.MatchString(src.Name) {
+			return fmt.Errorf("mirror: invalid resource name %q", src.Name)
+		}
+
+		stmts = append(stmts, `CREATE TABLE IF NOT EXISTS `+src.Name+` (
+			id         INTEGER PRIMARY KEY,
+			updated_at TEXT,
+			synced_at  TEXT NOT NULL,
+			data       TEXT NOT NULL
+		)`)
+	}
+
+	for _, stmt := range stmts {
+		if _, err := m.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sync synchronizes every source in order. It can be called again after a failure or a
+// cancellation of ctx to resume the synchronization.
+func (m *Mirror) Sync(ctx context.Context) error {
+	for _, src := range m.sources {
+		if err := m.SyncSource(ctx, src); err != nil {
+			return fmt.Errorf("mirror: sync %s: %w", src.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SyncSource synchronizes a single source, the source table must have been created by Init.
+func (m *Mirror) SyncSource(ctx context.Context, src source.Source) error {
+	cp, err := m.Checkpoint(ctx, src.Name)
+	if err != nil {
+		return err
+	}
+
+	if !src.Incremental {
+		return m.syncFull(ctx, src, cp)
+	}
+
+	pos := source.Position{Cursor: cp.Cursor, Page: cp.Page}
+
+	return source.WalkUpdated(ctx, src, pos, m.pageSize, func(records []source.Record, next source.Position) error {
+		cp.Cursor, cp.Page = next.Cursor, next.Page
+		if next.Page == 0 {
+			cp.CompletedAt = time.Now()
+		}
+
+		return m.store(ctx, src.Name, records, cp)
+	})
+}
+
+// syncFull crawls every page of a source that can not be synced incrementally.
+func (m *Mirror) syncFull(ctx context.Context, src source.Source, cp *Checkpoint) error {
+	params := src.Params.Merge(&fortytwo.Params{Sort: []string{"id"}})
+
+	next := *cp
+
+	err := source.Walk(ctx, src, params, m.pageSize, cp.Page, func(page int, records []source.Record) error {
+		next.Page = page + 1
+
+		if len(records) < m.pageSize {
+			next.Page = 0
+			next.CompletedAt = time.Now()
+		}
+
+		return m.store(ctx, src.Name, records, &next)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Walk also stops on a full last page, when the total is a multiple of the page size
+	if next.Page != 0 {
+		next.Page = 0
+		next.CompletedAt = time.Now()
+
+		return m.store(ctx, src.Name, nil, &next)
+	}
+
+	return nil
+}
+
+// store upserts a page of records and the checkpoint reached in a single transaction.
+func (m *Mirror) store(ctx context.Context, table string, records []source.Record, cp *Checkpoint) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+
+	for _, r := range records {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO `+table+` (id, updated_at, synced_at, data) VALUES (?, ?, ?, ?)
+			ON CONFLICT(id) DO UPDATE SET updated_at = excluded.updated_at, synced_at = excluded.synced_at, data = excluded.data`,
+			r.ID, formatTime(r.UpdatedAt), now, string(r.Data)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO `+checkpointTable+` (resource, cursor, page, completed_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT(resource) DO UPDATE SET cursor = excluded.cursor, page = excluded.page, completed_at = excluded.completed_at`,
+		table, formatTime(cp.Cursor), cp.Page, formatTime(cp.CompletedAt)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Checkpoint returns the synchronization progress of a resource, a zero checkpoint if it was never synced.
+func (m *Mirror) Checkpoint(ctx context.Context, resource string) (*Checkpoint, error) {
+	var cursor, completedAt sql.NullString
+
+	cp := &Checkpoint{Resource: resource}
+
+	err := m.db.QueryRowContext(ctx, `SELECT cursor, page, completed_at FROM `+checkpointTable+` WHERE resource = ?`, resource).
+		Scan(&cursor, &cp.Page, &completedAt)
+	if err == sql.ErrNoRows {
+		return cp, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cp.Cursor, err = parseTime(cursor); err != nil {
+		return nil, err
+	}
+
+	if cp.CompletedAt, err = parseTime(completedAt); err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+// Reset drops the checkpoint of a resource so that the next sync crawls every record again.
+func (m *Mirror) Reset(ctx context.Context, resource string) error {
+	_, err := m.db.ExecContext(ctx, `DELETE FROM `+checkpointTable+` WHERE resource = ?`, resource)
+
+	return err
+}
+
+func formatTime(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: t.UTC().Format(time.RFC3339Nano), Valid: true}
+}
+
+func parseTime(s sql.NullString) (time.Time, error) {
+	if !s.Valid || s.String == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339Nano, s.String)
+}
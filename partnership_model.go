@@ -0,0 +1,32 @@
+package fortytwo
+
+import "encoding/json"
+
+type Partnerships []Partnership
+
+type Partnership struct {
+	ID                   int    `json:"id"`
+	Name                 string `json:"name"`
+	Slug                 string `json:"slug"`
+	Tier                 int    `json:"tier"`
+	Url                  string `json:"url"`
+	PartnershipsUsersUrl string `json:"partnerships_users_url"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *Partnership) UnmarshalJSON(data []byte) error {
+	type partnership Partnership
+
+	extra, err := unmarshalExtra(data, (*partnership)(p))
+	p.Extra = extra
+
+	return err
+}
+
+func (p Partnership) MarshalJSON() ([]byte, error) {
+	type partnership Partnership
+
+	return marshalExtra(partnership(p), p.Extra)
+}
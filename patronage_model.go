@@ -0,0 +1,37 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Patronages []Patronage
+
+// Patronage links a user to their godfather, User.Patroned holds the godfathers of a user and
+// User.Patroning the users they are the godfather of.
+type Patronage struct {
+	ID          int       `json:"id"`
+	UserID      int       `json:"user_id"`
+	GodfatherID int       `json:"godfather_id"`
+	Ongoing     bool      `json:"ongoing"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *Patronage) UnmarshalJSON(data []byte) error {
+	type patronage Patronage
+
+	extra, err := unmarshalExtra(data, (*patronage)(p))
+	p.Extra = extra
+
+	return err
+}
+
+func (p Patronage) MarshalJSON() ([]byte, error) {
+	type patronage Patronage
+
+	return marshalExtra(patronage(p), p.Extra)
+}
@@ -0,0 +1,259 @@
+package fortytwo
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// unauthorizedCooldown is how long an application answering 401 is kept out of the pool.
+const unauthorizedCooldown = time.Minute
+
+// Credentials is the client ID/secret pair of a registered 42 application.
+type Credentials struct {
+	ClientID     string `json:"client_id" yaml:"client_id"`
+	ClientSecret string `json:"client_secret" yaml:"client_secret"`
+}
+
+// WithCredentialPool spreads the requests made with the application token across several 42 applications.
+// The credentials given to NewClient are always the first member of the pool.
+func WithCredentialPool(creds ...Credentials) ClientOption {
+	return func(c *Client) {
+		c.poolCredentials = append(c.poolCredentials, creds...)
+	}
+}
+
+type poolApp struct {
+	cfg       clientcredentials.Config
+	source    oauth2.TokenSource
+	rateLimit *RateLimit
+	coolUntil time.Time
+}
+
+// budget returns the number of requests the application may still send, unknown budgets come first.
+func (a *poolApp) budget() int {
+	if a.rateLimit == nil {
+		return math.MaxInt32
+	}
+
+	return a.rateLimit.HourlyRemaining
+}
+
+// credentialPool is an http.RoundTripper routing each request to the application with the most remaining budget
+// and failing over to the next one when an application is unauthorized or rate limited.
+type credentialPool struct {
+	ctx  context.Context
+	base http.RoundTripper
+
+	mu   sync.Mutex
+	apps []*poolApp
+}
+
+// newCredentialPool returns the pool of the applications of creds, it fails only when none of them
+// can authenticate.
+func newCredentialPool(ctx context.Context, base http.RoundTripper, creds []Credentials, scopes []string) (*credentialPool, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	p := &credentialPool{
+		ctx:  ctx,
+		base: base,
+	}
+
+	var (
+		authenticated bool
+		firstErr      error
+	)
+
+	for _, cred := range creds {
+		app := &poolApp{
+			cfg: clientcredentials.Config{
+				ClientID:     cred.ClientID,
+				ClientSecret: cred.ClientSecret,
+				Scopes:       scopes,
+				TokenURL:     apiToken,
+			},
+		}
+		app.source = app.cfg.TokenSource(ctx)
+
+		// A failing application is only kept out of the pool for a while, RoundTrip retries it later
+		if _, err := app.source.Token(); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			app.coolUntil = time.Now().Add(unauthorizedCooldown)
+		} else {
+			authenticated = true
+		}
+
+		p.apps = append(p.apps, app)
+	}
+
+	if !authenticated {
+		return nil, firstErr
+	}
+
+	return p, nil
+}
+
+// pick returns the untried application with the most remaining budget, applications cooling down come last.
+func (p *credentialPool) pick(tried map[*poolApp]bool) *poolApp {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	var best *poolApp
+
+	for _, app := range p.apps {
+		if tried[app] {
+			continue
+		}
+
+		if best == nil {
+			best = app
+
+			continue
+		}
+
+		appCooling, bestCooling := app.coolUntil.After(now), best.coolUntil.After(now)
+
+		switch {
+		case appCooling != bestCooling:
+			if !appCooling {
+				best = app
+			}
+		case appCooling:
+			if app.coolUntil.Before(best.coolUntil) {
+				best = app
+			}
+		case app.budget() > best.budget():
+			best = app
+		}
+	}
+
+	return best
+}
+
+func (p *credentialPool) update(app *poolApp, res *http.Response) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if rl := GetRateLimitInfo(res.Header); rl != nil {
+		app.rateLimit = rl
+	}
+
+	switch res.StatusCode {
+	case http.StatusUnauthorized:
+		// Drop the cached token, it has probably been revoked
+		app.source = app.cfg.TokenSource(p.ctx)
+		app.coolUntil = time.Now().Add(unauthorizedCooldown)
+	case http.StatusTooManyRequests:
+		wait := time.Second
+		if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil {
+			wait = time.Duration(seconds) * time.Second
+		}
+
+		app.coolUntil = time.Now().Add(wait)
+	}
+}
+
+// cool keeps an application out of the pool for a while.
+func (p *credentialPool) cool(app *poolApp, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	app.coolUntil = time.Now().Add(d)
+}
+
+// RateLimit returns the budget summed over every application of the pool.
+func (p *credentialPool) RateLimit() *RateLimit {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var total *RateLimit
+
+	for _, app := range p.apps {
+		if app.rateLimit == nil {
+			continue
+		}
+
+		if total == nil {
+			total = &RateLimit{}
+		}
+
+		total.HourlyLimit += app.rateLimit.HourlyLimit
+		total.HourlyRemaining += app.rateLimit.HourlyRemaining
+		total.SecondlyLimit += app.rateLimit.SecondlyLimit
+		total.SecondlyRemaining += app.rateLimit.SecondlyRemaining
+	}
+
+	return total
+}
+
+func (p *credentialPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	tried := make(map[*poolApp]bool, len(p.apps))
+
+	for {
+		app := p.pick(tried)
+		tried[app] = true
+
+		r := req.Clone(req.Context())
+
+		if len(tried) > 1 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			r.Body = body
+		}
+
+		p.mu.Lock()
+		source := app.source
+		p.mu.Unlock()
+
+		token, err := source.Token()
+		if err != nil {
+			// The other applications may still get a token, e.g. when this secret was revoked
+			p.cool(app, unauthorizedCooldown)
+
+			if len(tried) == len(p.apps) {
+				return nil, err
+			}
+
+			continue
+		}
+
+		token.SetAuthHeader(r)
+
+		res, err := p.base.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+
+		p.update(app, res)
+
+		if res.StatusCode != http.StatusUnauthorized && res.StatusCode != http.StatusTooManyRequests {
+			return res, nil
+		}
+
+		// Hand the last failure to the caller, it knows how to wait for a 429
+		canReplay := req.Body == nil || req.GetBody != nil
+		if len(tried) == len(p.apps) || !canReplay {
+			return res, nil
+		}
+
+		_, _ = io.Copy(io.Discard, res.Body)
+		closeBody(res.Body)
+	}
+}
@@ -2,9 +2,9 @@ package fortytwo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -18,6 +18,7 @@ type ProjectService interface {
 	GetProjectsByCursus(context.Context, CursusID) (*Projects, *PaginationResponse, error)
 
 	FindByID(context.Context, ProjectID) (*Project, error)
+	FindBySlug(context.Context, string) (*Project, error)
 	DeleteByID(context.Context, ProjectID) (*Project, error)
 }
 
@@ -27,7 +28,7 @@ type ProjectClient struct {
 
 // Get https://api.intra.42.fr/apidoc/2.0/projects/show.html
 func (a *ProjectClient) List(ctx context.Context, req *ProjectQueryRequest) (*Projects, *PaginationResponse, error) {
-	res, err := a.apiClient.request(ctx, http.MethodGet, "projects", "", req.Pagination.ToQuery(), nil)
+	res, err := a.apiClient.request(ctx, http.MethodGet, "projects", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -61,6 +62,18 @@ func (a *ProjectClient) FindByID(ctx context.Context, id ProjectID) (*Project, e
 	return handleProjectResponse(res)
 }
 
+// Get https://api.intra.42.fr/apidoc/2.0/projects/show.html
+func (a *ProjectClient) FindBySlug(ctx context.Context, slug string) (*Project, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("projects/%s", url.PathEscape(slug)), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleProjectResponse(res)
+}
+
 // Get https://api.intra.42.fr/apidoc/2.0/projects/show.html
 func (a *ProjectClient) DeleteByID(ctx context.Context, id ProjectID) (*Project, error) {
 	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("projects/%s", id.String()), "", nil, nil)
@@ -76,7 +89,7 @@ func (a *ProjectClient) DeleteByID(ctx context.Context, id ProjectID) (*Project,
 func handleProjectResponse(res *http.Response) (*Project, error) {
 	var response Project
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -86,7 +99,7 @@ func handleProjectResponse(res *http.Response) (*Project, error) {
 func handleProjectsResponse(res *http.Response) (*Projects, error) {
 	var response Projects
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -96,7 +109,7 @@ func handleProjectsResponse(res *http.Response) (*Projects, error) {
 func handleProjectsPaginatedResponse(res *http.Response) (*Projects, *PaginationResponse, error) {
 	var response Projects
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, nil, err
 	}
 
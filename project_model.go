@@ -1,24 +1,120 @@
 package fortytwo
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type ProjectID int
 
 type Projects []*Project
 
 type Project struct {
-	ID          int           `json:"id"`
-	Name        string        `json:"name"`
-	Slug        string        `json:"slug"`
-	Difficulty  int           `json:"difficulty"`
-	Description string        `json:"description"`
-	Parent      interface{}   `json:"parent"`
-	Children    []interface{} `json:"children"`
-	Objectives  []string      `json:"objectives"`
-	Attachments []interface{} `json:"attachments"`
-	CreatedAt   string        `json:"created_at"`
-	UpdatedAt   string        `json:"updated_at"`
-	Exam        bool          `json:"exam"`
+	ID              int              `json:"id"`
+	Name            string           `json:"name"`
+	Slug            string           `json:"slug"`
+	Difficulty      int              `json:"difficulty"`
+	Description     string           `json:"description"`
+	Tier            int              `json:"tier,omitempty"`
+	Parent          *ProjectSummary  `json:"parent"`
+	Children        []ProjectSummary `json:"children"`
+	Objectives      []string         `json:"objectives"`
+	Attachments     []Attachment     `json:"attachments"`
+	Videos          []Attachment     `json:"videos,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+	Exam            bool             `json:"exam"`
+	GitID           *int             `json:"git_id,omitempty"`
+	Repository      *string          `json:"repository,omitempty"`
+	Cursus          []Cursus         `json:"cursus,omitempty"`
+	Campus          []Campus         `json:"campus,omitempty"`
+	Skills          []Skill          `json:"skills,omitempty"`
+	Tags            []Tag            `json:"tags,omitempty"`
+	ProjectSessions []ProjectSession `json:"project_sessions,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type project Project
+
+	extra, err := unmarshalExtra(data, (*project)(p))
+	p.Extra = extra
+
+	return err
+}
+
+func (p Project) MarshalJSON() ([]byte, error) {
+	type project Project
+
+	return marshalExtra(project(p), p.Extra)
+}
+
+// Session returns the session of the project for a campus and a cursus, falling back on the
+// sessions not bound to a campus or a cursus, nil when the project has none.
+func (p *Project) Session(campusID CampusID, cursusID CursusID) *ProjectSession {
+	var best *ProjectSession
+
+	bestScore := -1
+
+	for i := range p.ProjectSessions {
+		s := &p.ProjectSessions[i]
+
+		score := 0
+
+		switch {
+		case s.CampusID == nil:
+		case *s.CampusID == int(campusID):
+			score += 2
+		default:
+			continue
+		}
+
+		switch {
+		case s.CursusID == nil:
+		case *s.CursusID == int(cursusID):
+			score++
+		default:
+			continue
+		}
+
+		if score > bestScore {
+			best, bestScore = s, score
+		}
+	}
+
+	return best
 }
 
 type ProjectQueryRequest struct {
 	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
+
+// ProjectSummary is the short form of a project embedded in the other resources.
+type ProjectSummary struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	ParentID *int   `json:"parent_id,omitempty"`
+	Url      string `json:"url,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *ProjectSummary) UnmarshalJSON(data []byte) error {
+	type projectSummary ProjectSummary
+
+	extra, err := unmarshalExtra(data, (*projectSummary)(p))
+	p.Extra = extra
+
+	return err
+}
+
+func (p ProjectSummary) MarshalJSON() ([]byte, error) {
+	type projectSummary ProjectSummary
+
+	return marshalExtra(projectSummary(p), p.Extra)
 }
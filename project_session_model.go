@@ -0,0 +1,155 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type ProjectSessionID int
+
+func (pID ProjectSessionID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type ProjectSessions []ProjectSession
+
+// ProjectSession holds the settings of a project for a campus and a cursus, CampusID and CursusID
+// are nil for the default session of the project.
+type ProjectSession struct {
+	ID         ProjectSessionID `json:"id"`
+	ProjectID  int              `json:"project_id"`
+	CampusID   *int             `json:"campus_id"`
+	CursusID   *int             `json:"cursus_id"`
+	Solo       bool             `json:"solo"`
+	BeginAt    *time.Time       `json:"begin_at"`
+	EndAt      *time.Time       `json:"end_at"`
+	Difficulty int              `json:"difficulty,omitempty"`
+	// EstimateTime is the estimated time to complete the project, in seconds
+	EstimateTime     *int                       `json:"estimate_time"`
+	DurationDays     *int                       `json:"duration_days"`
+	TerminatingAfter *int                       `json:"terminating_after"`
+	MaxPeople        *int                       `json:"max_people"`
+	IsSubscriptable  bool                       `json:"is_subscriptable"`
+	TeamBehaviour    string                     `json:"team_behaviour"`
+	Scales           []ProjectSessionScale      `json:"scales"`
+	Uploads          []ProjectSessionUpload     `json:"uploads"`
+	Evaluations      []ProjectSessionEvaluation `json:"evaluations,omitempty"`
+	CreatedAt        time.Time                  `json:"created_at"`
+	UpdatedAt        time.Time                  `json:"updated_at"`
+
+	Project *Project `json:"project,omitempty"`
+	Campus  *Campus  `json:"campus,omitempty"`
+	Cursus  *Cursus  `json:"cursus,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *ProjectSession) UnmarshalJSON(data []byte) error {
+	type projectSession ProjectSession
+
+	extra, err := unmarshalExtra(data, (*projectSession)(p))
+	p.Extra = extra
+
+	return err
+}
+
+func (p ProjectSession) MarshalJSON() ([]byte, error) {
+	type projectSession ProjectSession
+
+	return marshalExtra(projectSession(p), p.Extra)
+}
+
+// EstimatedDuration returns the estimated time to complete the project, false when the session has none.
+func (p *ProjectSession) EstimatedDuration() (time.Duration, bool) {
+	if p.EstimateTime == nil {
+		return 0, false
+	}
+
+	return time.Duration(*p.EstimateTime) * time.Second, true
+}
+
+// CorrectionNumber returns the number of evaluations of the primary scale, 0 when the session has none.
+func (p *ProjectSession) CorrectionNumber() int {
+	for _, s := range p.Scales {
+		if s.IsPrimary {
+			return s.CorrectionNumber
+		}
+	}
+
+	return 0
+}
+
+// ProjectSessionScale is a scale used to evaluate the teams of a session.
+type ProjectSessionScale struct {
+	ID               int  `json:"id"`
+	CorrectionNumber int  `json:"correction_number"`
+	IsPrimary        bool `json:"is_primary"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *ProjectSessionScale) UnmarshalJSON(data []byte) error {
+	type projectSessionScale ProjectSessionScale
+
+	extra, err := unmarshalExtra(data, (*projectSessionScale)(s))
+	s.Extra = extra
+
+	return err
+}
+
+func (s ProjectSessionScale) MarshalJSON() ([]byte, error) {
+	type projectSessionScale ProjectSessionScale
+
+	return marshalExtra(projectSessionScale(s), s.Extra)
+}
+
+// ProjectSessionUpload is an automated test run on the teams of a session.
+type ProjectSessionUpload struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *ProjectSessionUpload) UnmarshalJSON(data []byte) error {
+	type projectSessionUpload ProjectSessionUpload
+
+	extra, err := unmarshalExtra(data, (*projectSessionUpload)(s))
+	s.Extra = extra
+
+	return err
+}
+
+func (s ProjectSessionUpload) MarshalJSON() ([]byte, error) {
+	type projectSessionUpload ProjectSessionUpload
+
+	return marshalExtra(projectSessionUpload(s), s.Extra)
+}
+
+// ProjectSessionEvaluation is an evaluation step of a session, e.g. scale or upload.
+type ProjectSessionEvaluation struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *ProjectSessionEvaluation) UnmarshalJSON(data []byte) error {
+	type projectSessionEvaluation ProjectSessionEvaluation
+
+	extra, err := unmarshalExtra(data, (*projectSessionEvaluation)(s))
+	s.Extra = extra
+
+	return err
+}
+
+func (s ProjectSessionEvaluation) MarshalJSON() ([]byte, error) {
+	type projectSessionEvaluation ProjectSessionEvaluation
+
+	return marshalExtra(projectSessionEvaluation(s), s.Extra)
+}
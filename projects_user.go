@@ -0,0 +1,191 @@
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type ProjectsUserService interface {
+	List(context.Context, *ProjectsUserQueryRequest) (*ProjectsUsers, *PaginationResponse, error)
+	FindByID(context.Context, ProjectsUserID) (*ProjectsUser, error)
+	FindByUser(context.Context, UserID, *ProjectsUserQueryRequest) (*ProjectsUsers, *PaginationResponse, error)
+	FindByProject(context.Context, ProjectID, *ProjectsUserQueryRequest) (*ProjectsUsers, *PaginationResponse, error)
+
+	Create(context.Context, *ProjectsUserAttributes) (*ProjectsUser, error)
+	Register(ctx context.Context, token string, id ProjectID) (*ProjectsUser, error)
+	Update(context.Context, ProjectsUserID, *ProjectsUserAttributes) error
+	DeleteByID(context.Context, ProjectsUserID) error
+
+	Retry(ctx context.Context, id ProjectsUserID, force bool) error
+	Compile(context.Context, ProjectsUserID) error
+}
+
+type ProjectsUserClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/projects_users/index.html
+func (a *ProjectsUserClient) List(ctx context.Context, req *ProjectsUserQueryRequest) (*ProjectsUsers, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "projects_users", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleProjectsUsersPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/projects_users/show.html
+func (a *ProjectsUserClient) FindByID(ctx context.Context, id ProjectsUserID) (*ProjectsUser, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("projects_users/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleProjectsUserResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/projects_users/index.html
+func (a *ProjectsUserClient) FindByUser(ctx context.Context, id UserID, req *ProjectsUserQueryRequest) (*ProjectsUsers, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/projects_users", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleProjectsUsersPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/projects_users/index.html
+func (a *ProjectsUserClient) FindByProject(ctx context.Context, id ProjectID, req *ProjectsUserQueryRequest) (*ProjectsUsers, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("projects/%s/projects_users", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleProjectsUsersPaginatedResponse(res)
+}
+
+// Create creates the attempt of a user at a project, as a staff member.
+//
+// Post https://api.intra.42.fr/apidoc/2.0/projects_users/create.html
+func (a *ProjectsUserClient) Create(ctx context.Context, attrs *ProjectsUserAttributes) (*ProjectsUser, error) {
+	if attrs.Status != "" {
+		if err := ProjectsUserStatusValidator(attrs.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := a.apiClient.request(ctx, http.MethodPost, "projects_users", "", nil, map[string]*ProjectsUserAttributes{"projects_user": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleProjectsUserResponse(res)
+}
+
+// Register registers the user of the token to a project.
+//
+// Post https://api.intra.42.fr/apidoc/2.0/projects_users/create.html
+func (a *ProjectsUserClient) Register(ctx context.Context, tok string, id ProjectID) (*ProjectsUser, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, fmt.Sprintf("projects/%s/register", id.String()), tok, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleProjectsUserResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/projects_users/update.html
+func (a *ProjectsUserClient) Update(ctx context.Context, id ProjectsUserID, attrs *ProjectsUserAttributes) error {
+	if attrs.Status != "" {
+		if err := ProjectsUserStatusValidator(attrs.Status); err != nil {
+			return err
+		}
+	}
+
+	res, err := a.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("projects_users/%s", id.String()), "", nil, map[string]*ProjectsUserAttributes{"projects_user": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/projects_users/destroy.html
+func (a *ProjectsUserClient) DeleteByID(ctx context.Context, id ProjectsUserID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("projects_users/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Retry opens a new occurrence of a finished attempt, force retries before its retriable_at date.
+//
+// Patch https://api.intra.42.fr/apidoc/2.0/projects_users/retry.html
+func (a *ProjectsUserClient) Retry(ctx context.Context, id ProjectsUserID, force bool) error {
+	var query map[string]string
+	if force {
+		query = map[string]string{"force": "true"}
+	}
+
+	res, err := a.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("projects_users/%s/retry", id.String()), "", query, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Compile computes the final mark of an attempt from the marks of its teams.
+//
+// Patch https://api.intra.42.fr/apidoc/2.0/projects_users/compile.html
+func (a *ProjectsUserClient) Compile(ctx context.Context, id ProjectsUserID) error {
+	res, err := a.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("projects_users/%s/compile", id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+func handleProjectsUserResponse(res *http.Response) (*ProjectsUser, error) {
+	var response ProjectsUser
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleProjectsUsersPaginatedResponse(res *http.Response) (*ProjectsUsers, *PaginationResponse, error) {
+	var response ProjectsUsers
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
@@ -0,0 +1,86 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type ProjectsUserID int
+
+func (pID ProjectsUserID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type ProjectsUsers []ProjectsUser
+
+// ProjectsUser is an attempt of a user at a project.
+type ProjectsUser struct {
+	ID            ProjectsUserID     `json:"id"`
+	Occurrence    int                `json:"occurrence"`
+	FinalMark     *int               `json:"final_mark"`
+	Status        ProjectsUserStatus `json:"status"`
+	Validated     *bool              `json:"validated?"`
+	CurrentTeamID *int               `json:"current_team_id"`
+	Project       ProjectSummary     `json:"project"`
+	User          *UserSummary       `json:"user,omitempty"`
+	Teams         []Team             `json:"teams,omitempty"`
+	CursusIDs     []int              `json:"cursus_ids"`
+	MarkedAt      *time.Time         `json:"marked_at"`
+	Marked        bool               `json:"marked"`
+	RetriableAt   *time.Time         `json:"retriable_at"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *ProjectsUser) UnmarshalJSON(data []byte) error {
+	type projectsUser ProjectsUser
+
+	extra, err := unmarshalExtra(data, (*projectsUser)(p))
+	p.Extra = extra
+
+	return err
+}
+
+func (p ProjectsUser) MarshalJSON() ([]byte, error) {
+	type projectsUser ProjectsUser
+
+	return marshalExtra(projectsUser(p), p.Extra)
+}
+
+// CurrentTeam returns the team of the current attempt, nil when the payload has no teams.
+func (p *ProjectsUser) CurrentTeam() *Team {
+	if p.CurrentTeamID == nil {
+		return nil
+	}
+
+	for i := range p.Teams {
+		if int(p.Teams[i].ID) == *p.CurrentTeamID {
+			return &p.Teams[i]
+		}
+	}
+
+	return nil
+}
+
+// ProjectsUserAttributes are the attributes of the create and update requests of a project attempt.
+type ProjectsUserAttributes struct {
+	// ProjectID and UserID are required on create, a user has one attempt per project
+	ProjectID   int                `json:"project_id,omitempty"`
+	UserID      int                `json:"user_id,omitempty"`
+	Occurrence  *int               `json:"occurrence,omitempty"`
+	FinalMark   *int               `json:"final_mark,omitempty"`
+	Status      ProjectsUserStatus `json:"status,omitempty"`
+	MarkedAt    *time.Time         `json:"marked_at,omitempty"`
+	RetriableAt *time.Time         `json:"retriable_at,omitempty"`
+	// SkipCheckPermission creates the attempt without checking the prerequisites of the project
+	SkipCheckPermission bool `json:"skip_check_permission,omitempty"`
+}
+
+type ProjectsUserQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
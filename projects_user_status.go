@@ -0,0 +1,35 @@
+package fortytwo
+
+import "fmt"
+
+// ProjectsUserStatus defines the type for the status of a project attempt.
+type ProjectsUserStatus string
+
+// ProjectsUserStatus values.
+const (
+	ProjectsUserStatusSearchingAGroup      ProjectsUserStatus = "searching_a_group"
+	ProjectsUserStatusCreatingGroup        ProjectsUserStatus = "creating_group"
+	ProjectsUserStatusInProgress           ProjectsUserStatus = "in_progress"
+	ProjectsUserStatusWaitingForCorrection ProjectsUserStatus = "waiting_for_correction"
+	ProjectsUserStatusWaitingToStart       ProjectsUserStatus = "waiting_to_start"
+	ProjectsUserStatusFinished             ProjectsUserStatus = "finished"
+	ProjectsUserStatusParent               ProjectsUserStatus = "parent"
+)
+
+// String returns the string value for ProjectsUserStatus.
+func (ro ProjectsUserStatus) String() string {
+	return string(ro)
+}
+
+// ProjectsUserStatusValidator is a validator for the "ProjectsUserStatus" field enum values. It is called by
+// ProjectsUserService.Create and ProjectsUserService.Update.
+func ProjectsUserStatusValidator(ro ProjectsUserStatus) error {
+	switch ro {
+	case ProjectsUserStatusSearchingAGroup, ProjectsUserStatusCreatingGroup, ProjectsUserStatusInProgress,
+		ProjectsUserStatusWaitingForCorrection, ProjectsUserStatusWaitingToStart, ProjectsUserStatusFinished,
+		ProjectsUserStatusParent:
+		return nil
+	default:
+		return fmt.Errorf("invalid enum value for ProjectsUserStatus field: %q", ro)
+	}
+}
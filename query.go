@@ -0,0 +1,82 @@
+package fortytwo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Params holds the filter, range and sort parameters accepted by the list endpoints.
+//
+// https://api.intra.42.fr/apidoc/guides/specification#filtering
+type Params struct {
+	// Filter keeps the records whose field matches one of the values, e.g. filter[campus_id]=1,12
+	Filter map[string][]string
+	// Range keeps the records whose field is between the two bounds, e.g. range[updated_at]=min,max
+	Range map[string][2]string
+	// Sort orders the records by the fields, a field prefixed with "-" is sorted in descending order
+	Sort []string
+}
+
+func (p *Params) ToQuery() map[string]string {
+	if p == nil {
+		return nil
+	}
+	r := map[string]string{}
+	for field, values := range p.Filter {
+		r[fmt.Sprintf("filter[%s]", field)] = strings.Join(values, ",")
+	}
+
+	for field, bounds := range p.Range {
+		r[fmt.Sprintf("range[%s]", field)] = bounds[0] + "," + bounds[1]
+	}
+
+	if len(p.Sort) > 0 {
+		r["sort"] = strings.Join(p.Sort, ",")
+	}
+
+	return r
+}
+
+// Merge returns a copy of p overridden by the fields set in other.
+func (p *Params) Merge(other *Params) *Params {
+	r := &Params{
+		Filter: map[string][]string{},
+		Range:  map[string][2]string{},
+	}
+
+	for _, params := range []*Params{p, other} {
+		if params == nil {
+			continue
+		}
+
+		for field, values := range params.Filter {
+			r.Filter[field] = values
+		}
+
+		for field, bounds := range params.Range {
+			r.Range[field] = bounds
+		}
+
+		if len(params.Sort) > 0 {
+			r.Sort = params.Sort
+		}
+	}
+
+	return r
+}
+
+func mergeQuery(queries ...map[string]string) map[string]string {
+	var r map[string]string
+
+	for _, query := range queries {
+		for k, v := range query {
+			if r == nil {
+				r = map[string]string{}
+			}
+
+			r[k] = v
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,40 @@
+package fortytwo
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// RateLimit holds the request budget reported by the Intra42 API.
+//
+// https://api.intra.42.fr/apidoc/guides/getting_started#limits
+type RateLimit struct {
+	HourlyLimit       int
+	HourlyRemaining   int
+	SecondlyLimit     int
+	SecondlyRemaining int
+}
+
+// GetRateLimitInfo retrieves the rate limit information from the response header.
+func GetRateLimitInfo(h http.Header) *RateLimit {
+	hourlyLimit, err := strconv.Atoi(h.Get("X-Hourly-Ratelimit-Limit"))
+	if err != nil {
+		return nil
+	}
+
+	hourlyRemaining, err := strconv.Atoi(h.Get("X-Hourly-Ratelimit-Remaining"))
+	if err != nil {
+		return nil
+	}
+
+	// The secondly budget is optional, some applications are not throttled per second
+	secondlyLimit, _ := strconv.Atoi(h.Get("X-Secondly-Ratelimit-Limit"))
+	secondlyRemaining, _ := strconv.Atoi(h.Get("X-Secondly-Ratelimit-Remaining"))
+
+	return &RateLimit{
+		HourlyLimit:       hourlyLimit,
+		HourlyRemaining:   hourlyRemaining,
+		SecondlyLimit:     secondlyLimit,
+		SecondlyRemaining: secondlyRemaining,
+	}
+}
@@ -0,0 +1,29 @@
+package fortytwo
+
+import "encoding/json"
+
+type Roles []Role
+
+type Role struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Role) UnmarshalJSON(data []byte) error {
+	type role Role
+
+	extra, err := unmarshalExtra(data, (*role)(r))
+	r.Extra = extra
+
+	return err
+}
+
+func (r Role) MarshalJSON() ([]byte, error) {
+	type role Role
+
+	return marshalExtra(role(r), r.Extra)
+}
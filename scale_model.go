@@ -0,0 +1,70 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Scale is the grading sheet of the evaluations of a project.
+type Scale struct {
+	ID               int    `json:"id"`
+	EvaluationID     int    `json:"evaluation_id"`
+	Name             string `json:"name"`
+	IsPrimary        bool   `json:"is_primary"`
+	Comment          string `json:"comment"`
+	IntroductionMd   string `json:"introduction_md"`
+	DisclaimerMd     string `json:"disclaimer_md"`
+	GuidelinesMd     string `json:"guidelines_md"`
+	CorrectionNumber int    `json:"correction_number"`
+	// Duration is the duration of an evaluation, in seconds
+	Duration           int        `json:"duration"`
+	ManualSubscription bool       `json:"manual_subscription"`
+	Languages          []Language `json:"languages"`
+	CreatedAt          time.Time  `json:"created_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *Scale) UnmarshalJSON(data []byte) error {
+	type scale Scale
+
+	extra, err := unmarshalExtra(data, (*scale)(s))
+	s.Extra = extra
+
+	return err
+}
+
+func (s Scale) MarshalJSON() ([]byte, error) {
+	type scale Scale
+
+	return marshalExtra(scale(s), s.Extra)
+}
+
+// Flag is the outcome of an evaluation, e.g. Ok, Outstanding project or Cheat.
+type Flag struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Positive  bool      `json:"positive"`
+	Icon      string    `json:"icon"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (f *Flag) UnmarshalJSON(data []byte) error {
+	type flag Flag
+
+	extra, err := unmarshalExtra(data, (*flag)(f))
+	f.Extra = extra
+
+	return err
+}
+
+func (f Flag) MarshalJSON() ([]byte, error) {
+	type flag Flag
+
+	return marshalExtra(flag(f), f.Extra)
+}
@@ -0,0 +1,229 @@
+package fortytwo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type ScaleTeamService interface {
+	List(context.Context, *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+	FindByID(context.Context, ScaleTeamID) (*ScaleTeam, error)
+
+	FindByUser(context.Context, UserID, *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+	FindByUserAsCorrector(context.Context, UserID, *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+	FindByUserAsCorrected(context.Context, UserID, *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+	FindByProject(context.Context, ProjectID, *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+	FindByProjectSession(context.Context, ProjectSessionID, *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+
+	Me(ctx context.Context, token string, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+	MeAsCorrector(ctx context.Context, token string, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+	MeAsCorrected(ctx context.Context, token string, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error)
+
+	Create(context.Context, ProjectSessionID, *ScaleTeamAttributes) (*ScaleTeam, error)
+	MultipleCreate(context.Context, []ScaleTeamAttributes) (*ScaleTeams, error)
+	Update(context.Context, ProjectSessionID, ScaleTeamID, *ScaleTeamAttributes) error
+	DeleteByID(context.Context, ProjectSessionID, ScaleTeamID) error
+}
+
+type ScaleTeamClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) List(ctx context.Context, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "scale_teams", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/show.html
+func (a *ScaleTeamClient) FindByID(ctx context.Context, id ScaleTeamID) (*ScaleTeam, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("scale_teams/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) FindByUser(ctx context.Context, id UserID, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/scale_teams", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) FindByUserAsCorrector(ctx context.Context, id UserID, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/scale_teams/as_corrector", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) FindByUserAsCorrected(ctx context.Context, id UserID, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/scale_teams/as_corrected", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) FindByProject(ctx context.Context, id ProjectID, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("projects/%s/scale_teams", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) FindByProjectSession(ctx context.Context, id ProjectSessionID, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("project_sessions/%s/scale_teams", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) Me(ctx context.Context, tok string, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "me/scale_teams", tok, mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) MeAsCorrector(ctx context.Context, tok string, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "me/scale_teams/as_corrector", tok, mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/scale_teams/index.html
+func (a *ScaleTeamClient) MeAsCorrected(ctx context.Context, tok string, req *ScaleTeamQueryRequest) (*ScaleTeams, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "me/scale_teams/as_corrected", tok, mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsPaginatedResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/scale_teams/create.html
+func (a *ScaleTeamClient) Create(ctx context.Context, sessionID ProjectSessionID, attrs *ScaleTeamAttributes) (*ScaleTeam, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, fmt.Sprintf("project_sessions/%s/scale_teams", sessionID.String()), "", nil, map[string]*ScaleTeamAttributes{"scale_team": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamResponse(res)
+}
+
+// Post https://api.intra.42.fr/apidoc/2.0/scale_teams/multiple_create.html
+func (a *ScaleTeamClient) MultipleCreate(ctx context.Context, attrs []ScaleTeamAttributes) (*ScaleTeams, error) {
+	res, err := a.apiClient.request(ctx, http.MethodPost, "scale_teams/multiple_create", "", nil, map[string][]ScaleTeamAttributes{"scale_teams": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleScaleTeamsResponse(res)
+}
+
+// Patch https://api.intra.42.fr/apidoc/2.0/scale_teams/update.html
+func (a *ScaleTeamClient) Update(ctx context.Context, sessionID ProjectSessionID, id ScaleTeamID, attrs *ScaleTeamAttributes) error {
+	res, err := a.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("project_sessions/%s/scale_teams/%s", sessionID.String(), id.String()), "", nil, map[string]*ScaleTeamAttributes{"scale_team": attrs})
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/scale_teams/destroy.html
+func (a *ScaleTeamClient) DeleteByID(ctx context.Context, sessionID ProjectSessionID, id ScaleTeamID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("project_sessions/%s/scale_teams/%s", sessionID.String(), id.String()), "", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+func handleScaleTeamResponse(res *http.Response) (*ScaleTeam, error) {
+	var response ScaleTeam
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleScaleTeamsResponse(res *http.Response) (*ScaleTeams, error) {
+	var response ScaleTeams
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleScaleTeamsPaginatedResponse(res *http.Response) (*ScaleTeams, *PaginationResponse, error) {
+	var response ScaleTeams
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
@@ -0,0 +1,156 @@
+package fortytwo
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type ScaleTeamID int
+
+func (pID ScaleTeamID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type ScaleTeams []ScaleTeam
+
+// ScaleTeam is an evaluation of a team by a corrector.
+type ScaleTeam struct {
+	ID             ScaleTeamID    `json:"id"`
+	ScaleID        int            `json:"scale_id"`
+	Comment        *string        `json:"comment"`
+	Feedback       *string        `json:"feedback"`
+	FeedbackRating *int           `json:"feedback_rating"`
+	FinalMark      *int           `json:"final_mark"`
+	Flag           *Flag          `json:"flag"`
+	BeginAt        *time.Time     `json:"begin_at"`
+	FilledAt       *time.Time     `json:"filled_at"`
+	Corrector      ScaleTeamUser  `json:"corrector"`
+	Correcteds     ScaleTeamUsers `json:"correcteds"`
+	Truant         ScaleTeamUser  `json:"truant"`
+	Scale          *Scale         `json:"scale"`
+	Team           *Team          `json:"team,omitempty"`
+	Feedbacks      []Feedback     `json:"feedbacks"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *ScaleTeam) UnmarshalJSON(data []byte) error {
+	type scaleTeam ScaleTeam
+
+	extra, err := unmarshalExtra(data, (*scaleTeam)(s))
+	s.Extra = extra
+
+	return err
+}
+
+func (s ScaleTeam) MarshalJSON() ([]byte, error) {
+	type scaleTeam ScaleTeam
+
+	return marshalExtra(scaleTeam(s), s.Extra)
+}
+
+// Filled reports whether the corrector filled the scale of the evaluation.
+func (s *ScaleTeam) Filled() bool {
+	return s.FilledAt != nil
+}
+
+// invisible is the value of the users of an evaluation the token is not allowed to see yet.
+var invisible = []byte(`"invisible"`)
+
+// ScaleTeamUser is the corrector or the truant of an evaluation.
+type ScaleTeamUser struct {
+	// User is nil when the API sends an empty object or hides the user
+	User *UserSummary
+	// Invisible is true when the API hides the user until the evaluation begins
+	Invisible bool
+}
+
+func (u *ScaleTeamUser) UnmarshalJSON(data []byte) error {
+	*u = ScaleTeamUser{}
+
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case bytes.Equal(data, invisible):
+		u.Invisible = true
+
+		return nil
+	case bytes.Equal(data, []byte("null")), bytes.Equal(data, []byte("{}")):
+		return nil
+	}
+
+	return json.Unmarshal(data, &u.User)
+}
+
+func (u ScaleTeamUser) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.Invisible:
+		return invisible, nil
+	case u.User == nil:
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(u.User)
+}
+
+// ScaleTeamUsers are the evaluated users of an evaluation.
+type ScaleTeamUsers struct {
+	Users []UserSummary
+	// Invisible is true when the API hides the users until the evaluation begins
+	Invisible bool
+}
+
+func (u *ScaleTeamUsers) UnmarshalJSON(data []byte) error {
+	*u = ScaleTeamUsers{}
+
+	if bytes.Equal(bytes.TrimSpace(data), invisible) {
+		u.Invisible = true
+
+		return nil
+	}
+
+	return json.Unmarshal(data, &u.Users)
+}
+
+func (u ScaleTeamUsers) MarshalJSON() ([]byte, error) {
+	if u.Invisible {
+		return invisible, nil
+	}
+
+	if u.Users == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(u.Users)
+}
+
+// ScaleTeamAttributes are the attributes of the create and update requests of an evaluation.
+type ScaleTeamAttributes struct {
+	TeamID    int    `json:"team_id,omitempty"`
+	ScaleID   int    `json:"scale_id,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+	FlagID    *int   `json:"flag_id,omitempty"`
+	FinalMark *int   `json:"final_mark,omitempty"`
+	TruantID  *int   `json:"truant_id,omitempty"`
+	// BeginAt and UserID, the corrector, are only used by ScaleTeamService.MultipleCreate
+	BeginAt           *time.Time        `json:"begin_at,omitempty"`
+	UserID            *int              `json:"user_id,omitempty"`
+	AnswersAttributes []ScaleTeamAnswer `json:"answers_attributes,omitempty"`
+}
+
+// ScaleTeamAnswer is the answer to a question of the scale.
+type ScaleTeamAnswer struct {
+	ID         int  `json:"id,omitempty"`
+	QuestionID int  `json:"question_id"`
+	Value      *int `json:"value,omitempty"`
+}
+
+type ScaleTeamQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
@@ -1 +1,33 @@
 package fortytwo
+
+import "fmt"
+
+// Scope defines the type for the OAuth scopes of an application.
+//
+// https://api.intra.42.fr/apidoc/guides/getting_started#scopes
+type Scope string
+
+// Scope values.
+const (
+	ScopePublic    Scope = "public"
+	ScopeProjects  Scope = "projects"
+	ScopeProfile   Scope = "profile"
+	ScopeElearning Scope = "elearning"
+	ScopeTig       Scope = "tig"
+	ScopeForum     Scope = "forum"
+)
+
+// String returns the string value for Scope.
+func (s Scope) String() string {
+	return string(s)
+}
+
+// ScopeValidator is a validator for the "Scope" enum values.
+func ScopeValidator(s Scope) error {
+	switch s {
+	case ScopePublic, ScopeProjects, ScopeProfile, ScopeElearning, ScopeTig, ScopeForum:
+		return nil
+	default:
+		return fmt.Errorf("invalid enum value for Scope field: %q", s)
+	}
+}
@@ -0,0 +1,20 @@
+// Code generated by fortytwo-gen from docs/openapi3.json. DO NOT EDIT.
+
+package fortytwo
+
+// generatedServices holds the services generated from the OpenAPI specification, it is embedded in Client.
+type generatedServices struct {
+	Accreditation AccreditationService
+	Bloc          BlocService
+	Coalition     CoalitionService
+	Group         GroupService
+	Language      LanguageService
+}
+
+func (s *generatedServices) init(c *Client) {
+	s.Accreditation = &AccreditationClient{apiClient: c}
+	s.Bloc = &BlocClient{apiClient: c}
+	s.Coalition = &CoalitionClient{apiClient: c}
+	s.Group = &GroupClient{apiClient: c}
+	s.Language = &LanguageClient{apiClient: c}
+}
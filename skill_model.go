@@ -1,6 +1,7 @@
 package fortytwo
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -13,8 +14,27 @@ type Skill struct {
 	CreatedAt time.Time `json:"created_at"`
 	Name      string    `json:"name"`
 	Slug      string    `json:"slug"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *Skill) UnmarshalJSON(data []byte) error {
+	type skill Skill
+
+	extra, err := unmarshalExtra(data, (*skill)(s))
+	s.Extra = extra
+
+	return err
+}
+
+func (s Skill) MarshalJSON() ([]byte, error) {
+	type skill Skill
+
+	return marshalExtra(skill(s), s.Extra)
 }
 
 type SkillQueryRequest struct {
 	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
 }
@@ -0,0 +1,160 @@
+package fortytwo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type SlotService interface {
+	List(context.Context, *SlotQueryRequest) (*Slots, *PaginationResponse, error)
+	FindByID(context.Context, SlotID) (*Slot, error)
+	FindByUser(context.Context, UserID, *SlotQueryRequest) (*Slots, *PaginationResponse, error)
+	FindByProject(context.Context, ProjectID, *SlotQueryRequest) (*Slots, *PaginationResponse, error)
+
+	Me(ctx context.Context, token string, req *SlotQueryRequest) (*Slots, *PaginationResponse, error)
+
+	Create(ctx context.Context, token string, attrs *SlotAttributes) (*Slots, error)
+	DeleteByID(ctx context.Context, token string, id SlotID) error
+	DeleteWindow(ctx context.Context, token string, w *SlotWindow) error
+}
+
+type SlotClient struct {
+	apiClient *Client
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/slots/index.html
+func (a *SlotClient) List(ctx context.Context, req *SlotQueryRequest) (*Slots, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "slots", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleSlotsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/slots/show.html
+func (a *SlotClient) FindByID(ctx context.Context, id SlotID) (*Slot, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("slots/%s", id.String()), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleSlotResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/slots/index.html
+func (a *SlotClient) FindByUser(ctx context.Context, id UserID, req *SlotQueryRequest) (*Slots, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/slots", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleSlotsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/slots/index.html
+func (a *SlotClient) FindByProject(ctx context.Context, id ProjectID, req *SlotQueryRequest) (*Slots, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("projects/%s/slots", id.String()), "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleSlotsPaginatedResponse(res)
+}
+
+// Get https://api.intra.42.fr/apidoc/2.0/slots/index.html
+func (a *SlotClient) Me(ctx context.Context, tok string, req *SlotQueryRequest) (*Slots, *PaginationResponse, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, "me/slots", tok, mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleSlotsPaginatedResponse(res)
+}
+
+// Create sets the user of the token available, the API splits the slot in fragments of SlotGranularity.
+//
+// Post https://api.intra.42.fr/apidoc/2.0/slots/create.html
+func (a *SlotClient) Create(ctx context.Context, tok string, attrs *SlotAttributes) (*Slots, error) {
+	if attrs.BeginAt == nil || attrs.EndAt == nil {
+		return nil, errors.New("slot without begin_at or end_at")
+	}
+
+	if err := ValidateSlot(*attrs.BeginAt, *attrs.EndAt); err != nil {
+		return nil, err
+	}
+
+	res, err := a.apiClient.request(ctx, http.MethodPost, "slots", tok, nil, map[string]*SlotAttributes{"slot": attrs})
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleSlotsResponse(res)
+}
+
+// Delete https://api.intra.42.fr/apidoc/2.0/slots/destroy.html
+func (a *SlotClient) DeleteByID(ctx context.Context, tok string, id SlotID) error {
+	res, err := a.apiClient.request(ctx, http.MethodDelete, fmt.Sprintf("slots/%s", id.String()), tok, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	closeBody(res.Body)
+
+	return nil
+}
+
+// DeleteWindow deletes the slots of a window, stopping at the first error.
+func (a *SlotClient) DeleteWindow(ctx context.Context, tok string, w *SlotWindow) error {
+	for _, id := range w.Slots {
+		if err := a.DeleteByID(ctx, tok, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func handleSlotResponse(res *http.Response) (*Slot, error) {
+	var response Slot
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleSlotsResponse(res *http.Response) (*Slots, error) {
+	var response Slots
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func handleSlotsPaginatedResponse(res *http.Response) (*Slots, *PaginationResponse, error) {
+	var response Slots
+
+	if err := decodeJSON(res.Body, &response); err != nil {
+		return nil, nil, err
+	}
+
+	return &response, GetPaginationInfo(res.Header), nil
+}
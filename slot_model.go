@@ -0,0 +1,158 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SlotGranularity is the granularity of the slots, the API stores a slot as fragments of this duration.
+const SlotGranularity = 15 * time.Minute
+
+// ErrSlotAlignment is returned by the create calls of SlotService when a slot is not aligned on SlotGranularity.
+var ErrSlotAlignment = errors.New("slot not aligned on 15 minutes")
+
+type SlotID int
+
+func (pID SlotID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type Slots []Slot
+
+// Slot is a 15 minutes fragment of the time a user is available to evaluate other users.
+type Slot struct {
+	ID      SlotID    `json:"id"`
+	BeginAt time.Time `json:"begin_at"`
+	EndAt   time.Time `json:"end_at"`
+	// ScaleTeam is the evaluation booked on the slot, nil when the slot is free
+	ScaleTeam *ScaleTeam `json:"scale_team"`
+	// User is the corrector, hidden to the other users
+	User ScaleTeamUser `json:"user"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *Slot) UnmarshalJSON(data []byte) error {
+	type slot Slot
+
+	extra, err := unmarshalExtra(data, (*slot)(s))
+	s.Extra = extra
+
+	return err
+}
+
+func (s Slot) MarshalJSON() ([]byte, error) {
+	type slot Slot
+
+	return marshalExtra(slot(s), s.Extra)
+}
+
+// SlotWindow is a contiguous availability of a corrector made of several slots.
+type SlotWindow struct {
+	BeginAt   time.Time
+	EndAt     time.Time
+	User      ScaleTeamUser
+	ScaleTeam *ScaleTeam
+	// Slots are the IDs of the fragments of the window, to delete it
+	Slots []SlotID
+}
+
+// Duration returns the duration of the window.
+func (w *SlotWindow) Duration() time.Duration {
+	return w.EndAt.Sub(w.BeginAt)
+}
+
+// MergeSlots merges the contiguous slots of the same corrector and the same evaluation into
+// windows, sorted by corrector and begin date. The slots of the hidden correctors are never merged,
+// nothing tells whether two of them belong to the same corrector: each one is its own window.
+func MergeSlots(slots []Slot) []SlotWindow {
+	sorted := make([]Slot, len(slots))
+	copy(sorted, slots)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if ki, kj := slotOwner(&sorted[i]), slotOwner(&sorted[j]); ki != kj {
+			return ki < kj
+		}
+
+		return sorted[i].BeginAt.Before(sorted[j].BeginAt)
+	})
+
+	var windows []SlotWindow
+
+	for i := range sorted {
+		s := &sorted[i]
+
+		if n := len(windows); n > 0 {
+			last := &windows[n-1]
+
+			if last.EndAt.Equal(s.BeginAt) && s.User.User != nil && slotOwner(s) == slotOwner(&sorted[i-1]) &&
+				scaleTeamID(s.ScaleTeam) == scaleTeamID(last.ScaleTeam) {
+				last.EndAt = s.EndAt
+				last.Slots = append(last.Slots, s.ID)
+
+				continue
+			}
+		}
+
+		windows = append(windows, SlotWindow{
+			BeginAt:   s.BeginAt,
+			EndAt:     s.EndAt,
+			User:      s.User,
+			ScaleTeam: s.ScaleTeam,
+			Slots:     []SlotID{s.ID},
+		})
+	}
+
+	return windows
+}
+
+// slotOwner returns the ID of the corrector of a slot, -1 when hidden.
+func slotOwner(s *Slot) int {
+	if s.User.User == nil {
+		return -1
+	}
+
+	return s.User.User.ID
+}
+
+func scaleTeamID(st *ScaleTeam) ScaleTeamID {
+	if st == nil {
+		return 0
+	}
+
+	return st.ID
+}
+
+// ValidateSlot checks that a slot begins and ends on SlotGranularity and ends after it begins.
+func ValidateSlot(beginAt, endAt time.Time) error {
+	for _, t := range []time.Time{beginAt, endAt} {
+		if !t.Truncate(SlotGranularity).Equal(t) {
+			return fmt.Errorf("%w: %s", ErrSlotAlignment, t.Format(time.RFC3339Nano))
+		}
+	}
+
+	if !endAt.After(beginAt) {
+		return fmt.Errorf("slot must end after it begins: %s - %s", beginAt.Format(time.RFC3339), endAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// SlotAttributes are the attributes of the create and update requests of a slot.
+type SlotAttributes struct {
+	// UserID is required on create, the API only lets the advanced tutors set another user than the token owner
+	UserID      int        `json:"user_id,omitempty"`
+	BeginAt     *time.Time `json:"begin_at,omitempty"`
+	EndAt       *time.Time `json:"end_at,omitempty"`
+	ScaleTeamID *int       `json:"scale_team_id,omitempty"`
+}
+
+type SlotQueryRequest struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
+}
@@ -0,0 +1,302 @@
+// Package source describes the paginated list endpoints of the 42 API as streams of raw JSON records,
+// so that tools such as mirror can crawl them without knowing the concrete models.
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// DefaultPageSize is the maximum page size accepted by the 42 API.
+const DefaultPageSize = 100
+
+// farFuture is the upper bound of the updated_at range, the API requires both bounds.
+var farFuture = time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Record is a single resource returned by a list endpoint.
+type Record struct {
+	ID        int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Data      json.RawMessage
+}
+
+// Position is where a walk over updated records resumes.
+type Position struct {
+	// Cursor is the updated_at of the most recent record seen
+	Cursor time.Time
+	// Page is the next page to fetch for the cursor, 0 once the walk reached the last record
+	Page int
+}
+
+// FetchFunc returns a single page of records matching the params.
+type FetchFunc func(ctx context.Context, page *fortytwo.Pagination, params *fortytwo.Params) ([]Record, *fortytwo.PaginationResponse, error)
+
+// Source is a paginated list endpoint of the 42 API.
+type Source struct {
+	// Name identifies the resource, e.g. "users"
+	Name string
+	// Incremental is true when the endpoint supports range[updated_at] and sort=updated_at
+	Incremental bool
+	// Params are sent with every request, e.g. a filter on the campus
+	Params *fortytwo.Params
+	// Fetch returns a single page of records
+	Fetch FetchFunc
+}
+
+// UpdatedSince returns the params selecting the records updated at or after t, oldest first.
+func UpdatedSince(t time.Time) *fortytwo.Params {
+	p := &fortytwo.Params{
+		Sort: []string{"updated_at", "id"},
+	}
+
+	if !t.IsZero() {
+		p.Range = map[string][2]string{
+			"updated_at": {t.UTC().Format(time.RFC3339Nano), farFuture.Format(time.RFC3339)},
+		}
+	}
+
+	return p
+}
+
+// Walk calls fn with every page of records matching the params, starting at the given page number.
+// It stops at the last page or at the first error returned by fn.
+func Walk(ctx context.Context, src Source, params *fortytwo.Params, pageSize, page int, fn func(page int, records []Record) error) error {
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	if page == 0 {
+		page = 1
+	}
+
+	params = src.Params.Merge(params)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		records, pag, err := src.Fetch(ctx, &fortytwo.Pagination{Cursor: page, PageSize: pageSize}, params)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(page, records); err != nil {
+			return err
+		}
+
+		if len(records) < pageSize || (pag != nil && !pag.HasNext) {
+			return nil
+		}
+
+		page++
+	}
+}
+
+// WalkUpdated calls fn with every page of records updated at or after the position cursor, oldest first,
+// along with the position to resume from once the page has been handled.
+//
+// Every page moves the cursor forward and restarts at page 1, so that records updated during the walk
+// can not shift unseen records to an already fetched page. The page number only grows when a whole page
+// shares the cursor timestamp. The source must be incremental.
+func WalkUpdated(ctx context.Context, src Source, pos Position, pageSize int, fn func(records []Record, next Position) error) error {
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	if pos.Page == 0 {
+		pos.Page = 1
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		params := src.Params.Merge(UpdatedSince(pos.Cursor))
+
+		records, _, err := src.Fetch(ctx, &fortytwo.Pagination{Cursor: pos.Page, PageSize: pageSize}, params)
+		if err != nil {
+			return err
+		}
+
+		next := pos
+
+		if len(records) > 0 {
+			if last := records[len(records)-1].UpdatedAt; last.After(pos.Cursor) {
+				next = Position{Cursor: last, Page: 1}
+			} else {
+				next.Page++
+			}
+		}
+
+		done := len(records) < pageSize
+		if done {
+			next.Page = 0
+		}
+
+		if err := fn(records, next); err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		pos = next
+	}
+}
+
+// Users lists /v2/users.
+func Users(c *fortytwo.Client) Source {
+	return Source{
+		Name:        "users",
+		Incremental: true,
+		Fetch: func(ctx context.Context, page *fortytwo.Pagination, params *fortytwo.Params) ([]Record, *fortytwo.PaginationResponse, error) {
+			users, pag, err := c.User.List(ctx, &fortytwo.CursusQueryRequest{Pagination: page, Params: params})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			records := make([]Record, 0, len(*users))
+
+			for _, u := range *users {
+				r, err := newRecord(u.ID, u.CreatedAt, u.UpdatedAt, u)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				records = append(records, r)
+			}
+
+			return records, pag, nil
+		},
+	}
+}
+
+// CursusUsers lists /v2/cursus_users.
+func CursusUsers(c *fortytwo.Client) Source {
+	return Source{
+		Name:        "cursus_users",
+		Incremental: true,
+		Fetch: func(ctx context.Context, page *fortytwo.Pagination, params *fortytwo.Params) ([]Record, *fortytwo.PaginationResponse, error) {
+			cursusUsers, pag, err := c.CursusUser.List(ctx, &fortytwo.CursusUserQueryRequest{Pagination: page, Params: params})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			records := make([]Record, 0, len(*cursusUsers))
+
+			for _, cu := range *cursusUsers {
+				r, err := newRecord(cu.ID, cu.CreatedAt, cu.UpdatedAt, cu)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				records = append(records, r)
+			}
+
+			return records, pag, nil
+		},
+	}
+}
+
+// Projects lists /v2/projects.
+func Projects(c *fortytwo.Client) Source {
+	return Source{
+		Name:        "projects",
+		Incremental: true,
+		Fetch: func(ctx context.Context, page *fortytwo.Pagination, params *fortytwo.Params) ([]Record, *fortytwo.PaginationResponse, error) {
+			projects, pag, err := c.Project.List(ctx, &fortytwo.ProjectQueryRequest{Pagination: page, Params: params})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			records := make([]Record, 0, len(*projects))
+
+			for _, p := range *projects {
+				r, err := newRecord(p.ID, p.CreatedAt, p.UpdatedAt, p)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				records = append(records, r)
+			}
+
+			return records, pag, nil
+		},
+	}
+}
+
+// Locations lists /v2/locations. Locations carry no updated_at, the records are dated by their
+// begin_at and end_at, an active session being updated at its begin_at.
+func Locations(c *fortytwo.Client) Source {
+	return Source{
+		Name:        "locations",
+		Incremental: false,
+		Fetch: func(ctx context.Context, page *fortytwo.Pagination, params *fortytwo.Params) ([]Record, *fortytwo.PaginationResponse, error) {
+			locations, pag, err := c.Location.List(ctx, &fortytwo.LocationQueryRequest{Pagination: page, Params: params})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			records := make([]Record, 0, len(*locations))
+
+			for _, l := range *locations {
+				updatedAt := l.BeginAt
+				if l.EndAt != nil {
+					updatedAt = *l.EndAt
+				}
+
+				r, err := newRecord(int(l.ID), l.BeginAt, updatedAt, l)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				records = append(records, r)
+			}
+
+			return records, pag, nil
+		},
+	}
+}
+
+// Achievements lists /v2/achievements. Achievements carry no updated_at, they are always fully synced.
+func Achievements(c *fortytwo.Client) Source {
+	return Source{
+		Name:        "achievements",
+		Incremental: false,
+		Fetch: func(ctx context.Context, page *fortytwo.Pagination, params *fortytwo.Params) ([]Record, *fortytwo.PaginationResponse, error) {
+			achievements, pag, err := c.Achievement.List(ctx, &fortytwo.AchievementQueryRequest{Pagination: page, Params: params})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			records := make([]Record, 0, len(*achievements))
+
+			for _, a := range *achievements {
+				r, err := newRecord(a.ID, time.Time{}, time.Time{}, a)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				records = append(records, r)
+			}
+
+			return records, pag, nil
+		},
+	}
+}
+
+func newRecord(id int, createdAt, updatedAt time.Time, v interface{}) (Record, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Record{}, err
+	}
+
+	return Record{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt, Data: data}, nil
+}
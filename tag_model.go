@@ -0,0 +1,29 @@
+package fortytwo
+
+import "encoding/json"
+
+type Tags []Tag
+
+type Tag struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *Tag) UnmarshalJSON(data []byte) error {
+	type tag Tag
+
+	extra, err := unmarshalExtra(data, (*tag)(t))
+	t.Extra = extra
+
+	return err
+}
+
+func (t Tag) MarshalJSON() ([]byte, error) {
+	type tag Tag
+
+	return marshalExtra(tag(t), t.Extra)
+}
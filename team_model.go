@@ -0,0 +1,95 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type TeamID int
+
+func (pID TeamID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+type Teams []Team
+
+// Team is a group of users registered together on a project session.
+type Team struct {
+	ID               TeamID     `json:"id"`
+	Name             string     `json:"name"`
+	Url              string     `json:"url"`
+	FinalMark        *int       `json:"final_mark"`
+	ProjectID        int        `json:"project_id"`
+	ProjectSessionID int        `json:"project_session_id"`
+	Status           string     `json:"status"`
+	TerminatingAt    *time.Time `json:"terminating_at"`
+	Users            []TeamUser `json:"users"`
+	Locked           bool       `json:"locked?"`
+	Validated        *bool      `json:"validated?"`
+	Closed           bool       `json:"closed?"`
+	RepoUrl          *string    `json:"repo_url"`
+	RepoUuid         string     `json:"repo_uuid"`
+	LockedAt         *time.Time `json:"locked_at"`
+	ClosedAt         *time.Time `json:"closed_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *Team) UnmarshalJSON(data []byte) error {
+	type team Team
+
+	extra, err := unmarshalExtra(data, (*team)(t))
+	t.Extra = extra
+
+	return err
+}
+
+func (t Team) MarshalJSON() ([]byte, error) {
+	type team Team
+
+	return marshalExtra(team(t), t.Extra)
+}
+
+// Leader returns the leader of the team, nil when the team has none.
+func (t *Team) Leader() *TeamUser {
+	for i := range t.Users {
+		if t.Users[i].Leader {
+			return &t.Users[i]
+		}
+	}
+
+	return nil
+}
+
+// TeamUser is a member of a team.
+type TeamUser struct {
+	ID             int            `json:"id"`
+	Login          string         `json:"login"`
+	Url            string         `json:"url"`
+	Leader         bool           `json:"leader"`
+	Occurrence     int            `json:"occurrence"`
+	Validated      bool           `json:"validated"`
+	ProjectsUserID ProjectsUserID `json:"projects_user_id"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *TeamUser) UnmarshalJSON(data []byte) error {
+	type teamUser TeamUser
+
+	extra, err := unmarshalExtra(data, (*teamUser)(t))
+	t.Extra = extra
+
+	return err
+}
+
+func (t TeamUser) MarshalJSON() ([]byte, error) {
+	type teamUser TeamUser
+
+	return marshalExtra(teamUser(t), t.Extra)
+}
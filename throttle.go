@@ -0,0 +1,54 @@
+package fortytwo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithRateLimit spaces the requests so that at most perSecond requests are sent every second,
+// the 42 API allows 2 requests per second to a default application
+func WithRateLimit(perSecond float64) ClientOption {
+	return func(c *Client) {
+		if perSecond <= 0 {
+			c.throttle = nil
+
+			return
+		}
+
+		c.throttle = &throttle{interval: time.Duration(float64(time.Second) / perSecond)}
+	}
+}
+
+// throttle hands out evenly spaced sending slots.
+type throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the next free slot or until ctx is done.
+func (t *throttle) wait(ctx context.Context) error {
+	t.mu.Lock()
+	now := time.Now()
+
+	slot := t.next
+	if slot.Before(now) {
+		slot = now
+	}
+
+	t.next = slot.Add(t.interval)
+	t.mu.Unlock()
+
+	delay := slot.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
@@ -2,7 +2,6 @@ package fortytwo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -19,7 +18,7 @@ type TitleClient struct {
 
 // Get https://api.intra.42.fr/apidoc/2.0/titles/show.html
 func (a *TitleClient) List(ctx context.Context, req *TitleQueryRequest) (*Titles, *PaginationResponse, error) {
-	res, err := a.apiClient.request(ctx, http.MethodGet, "titles", "", req.Pagination.ToQuery(), nil)
+	res, err := a.apiClient.request(ctx, http.MethodGet, "titles", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -44,7 +43,7 @@ func (a *TitleClient) FindByID(ctx context.Context, id TitleID) (*Title, error)
 func handleTitleResponse(res *http.Response) (*Title, error) {
 	var response Title
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -54,7 +53,7 @@ func handleTitleResponse(res *http.Response) (*Title, error) {
 func handleTitlesResponse(res *http.Response) (*Titles, error) {
 	var response Titles
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -64,7 +63,7 @@ func handleTitlesResponse(res *http.Response) (*Titles, error) {
 func handleTitlesPaginatedResponse(res *http.Response) (*Titles, *PaginationResponse, error) {
 	var response Titles
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, nil, err
 	}
 
@@ -1,6 +1,7 @@
 package fortytwo
 
 import (
+	"encoding/json"
 	"strconv"
 )
 
@@ -15,8 +16,27 @@ type Titles []*Title
 type Title struct {
 	ID   TitleID `json:"id"`
 	Name string  `json:"name"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *Title) UnmarshalJSON(data []byte) error {
+	type title Title
+
+	extra, err := unmarshalExtra(data, (*title)(t))
+	t.Extra = extra
+
+	return err
+}
+
+func (t Title) MarshalJSON() ([]byte, error) {
+	type title Title
+
+	return marshalExtra(title(t), t.Extra)
 }
 
 type TitleQueryRequest struct {
 	Pagination *Pagination `json:"pagination,omitempty"`
+	Params     *Params     `json:"params,omitempty"`
 }
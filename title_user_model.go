@@ -0,0 +1,35 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type TitleUsers []TitleUser
+
+type TitleUser struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	TitleID   TitleID   `json:"title_id"`
+	Selected  bool      `json:"selected"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *TitleUser) UnmarshalJSON(data []byte) error {
+	type titleUser TitleUser
+
+	extra, err := unmarshalExtra(data, (*titleUser)(t))
+	t.Extra = extra
+
+	return err
+}
+
+func (t TitleUser) MarshalJSON() ([]byte, error) {
+	type titleUser TitleUser
+
+	return marshalExtra(titleUser(t), t.Extra)
+}
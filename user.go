@@ -2,9 +2,9 @@ package fortytwo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -18,9 +18,10 @@ type UserService interface {
 	List(ctx context.Context, req *CursusQueryRequest) (*Users, *PaginationResponse, error)
 
 	FindByID(ctx context.Context, id UserID) (*User, error)
+	FindByLogin(ctx context.Context, login string) (*User, error)
 	FindByCampus(ctx context.Context, id CursusID) (*Users, error)
 
-	LocationStats(ctx context.Context, id UserID) (*LocationsStat, error)
+	LocationStats(ctx context.Context, id UserID) (*LocationStats, error)
 }
 
 type UserClient struct {
@@ -29,7 +30,7 @@ type UserClient struct {
 
 // Get https://api.intra.42.fr/apidoc/2.0/Users/show.html
 func (a *UserClient) List(ctx context.Context, req *CursusQueryRequest) (*Users, *PaginationResponse, error) {
-	res, err := a.apiClient.request(ctx, http.MethodGet, "users", "", req.Pagination.ToQuery(), nil)
+	res, err := a.apiClient.request(ctx, http.MethodGet, "users", "", mergeQuery(req.Pagination.ToQuery(), req.Params.ToQuery()), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,6 +52,18 @@ func (a *UserClient) FindByID(ctx context.Context, id UserID) (*User, error) {
 	return handleUserResponse(res)
 }
 
+// Get https://api.intra.42.fr/apidoc/2.0/Users/show.html
+func (a *UserClient) FindByLogin(ctx context.Context, login string) (*User, error) {
+	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s", url.PathEscape(login)), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(res.Body)
+
+	return handleUserResponse(res)
+}
+
 // Get https://api.intra.42.fr/apidoc/2.0/Users/show.html
 func (a *UserClient) FindByCampus(ctx context.Context, id CursusID) (*Users, error) {
 	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("cursus/%s/users", id), "", nil, nil)
@@ -76,7 +89,7 @@ func (a *UserClient) Me(ctx context.Context, tok string) (*User, error) {
 }
 
 // Get https://api.intra.42.fr/apidoc/2.0/Users/show.html
-func (a *UserClient) LocationStats(ctx context.Context, id UserID) (*LocationsStat, error) {
+func (a *UserClient) LocationStats(ctx context.Context, id UserID) (*LocationStats, error) {
 	res, err := a.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("users/%s/locations_stats", id.String()), "", nil, nil)
 	if err != nil {
 		return nil, err
@@ -84,19 +97,13 @@ func (a *UserClient) LocationStats(ctx context.Context, id UserID) (*LocationsSt
 
 	defer closeBody(res.Body)
 
-	var response LocationsStat
-
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
-		return nil, err
-	}
-
-	return &response, nil
+	return handleLocationStatsResponse(res)
 }
 
 func handleUserResponse(res *http.Response) (*User, error) {
 	var response User
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +113,7 @@ func handleUserResponse(res *http.Response) (*User, error) {
 func handleUsersResponse(res *http.Response) (*Users, error) {
 	var response Users
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, err
 	}
 
@@ -116,7 +123,7 @@ func handleUsersResponse(res *http.Response) (*Users, error) {
 func handleUsersPaginatedResponse(res *http.Response) (*Users, *PaginationResponse, error) {
 	var response Users
 
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := decodeJSON(res.Body, &response); err != nil {
 		return nil, nil, err
 	}
 
@@ -0,0 +1,16 @@
+package fortytwo
+
+// UserKind defines the type for the kind of a user.
+type UserKind string
+
+// UserKind values.
+const (
+	UserKindAdmin    UserKind = "admin"
+	UserKindStudent  UserKind = "student"
+	UserKindExternal UserKind = "external"
+)
+
+// String returns the string value for UserKind.
+func (ro UserKind) String() string {
+	return string(ro)
+}
@@ -1,43 +1,115 @@
 package fortytwo
 
 import (
+	"encoding/json"
 	"time"
 )
 
 type UserID int
 
-type LocationsStat interface{}
-
 type Users []User
 
+// User is the payload of /v2/users/:id and /v2/me, the lists of users only fill the fields up to Active.
 type User struct {
-	CreatedAt       time.Time      `json:"created_at"`
-	UpdatedAt       time.Time      `json:"updated_at"`
-	ID              int            `json:"id"`
-	Email           string         `json:"email"`
-	Login           string         `json:"login"`
-	FirstName       string         `json:"first_name"`
-	LastName        string         `json:"last_name"`
-	UsualFullName   string         `json:"usual_full_name"`
-	UsualFirstName  string         `json:"usual_first_name"`
-	Url             string         `json:"url"`
-	Phone           string         `json:"phone"`
-	Displayname     string         `json:"displayname"`
-	Kind            string         `json:"kind"`
-	Image           Image          `json:"image"`
-	Staff           bool           `json:"staff?"`
-	CorrectionPoint int            `json:"correction_point"`
-	PoolMonth       string         `json:"pool_month"`
-	PoolYear        string         `json:"pool_year"`
-	Location        interface{}    `json:"location"`
-	Wallet          int            `json:"wallet"`
-	AnonymizeDate   time.Time      `json:"anonymize_date"`
-	DataErasureDate time.Time      `json:"data_erasure_date,omitempty"`
-	AlumnizedAt     time.Time      `json:"alumnized_at,omitempty"`
-	Alumni          bool           `json:"alumni?"`
-	Active          bool           `json:"active?"`
-	Groups          []interface{}  `json:"groups"`
-	CursusUsers     []CursusUser   `json:"cursus_users"`
-	ProjectsUsers   []interface{}  `json:"projects_users"`
-	LanguagesUsers  []LanguageUser `json:"languages_users"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	ID              int       `json:"id"`
+	Email           string    `json:"email"`
+	Login           string    `json:"login"`
+	FirstName       string    `json:"first_name"`
+	LastName        string    `json:"last_name"`
+	UsualFullName   string    `json:"usual_full_name"`
+	UsualFirstName  *string   `json:"usual_first_name"`
+	Url             string    `json:"url"`
+	Phone           *string   `json:"phone"`
+	Displayname     string    `json:"displayname"`
+	Kind            UserKind  `json:"kind"`
+	Image           Image     `json:"image"`
+	Staff           bool      `json:"staff?"`
+	CorrectionPoint int       `json:"correction_point"`
+	PoolMonth       string    `json:"pool_month"`
+	PoolYear        string    `json:"pool_year"`
+	// Location is the host the user is logged on, nil when the user is not logged
+	Location        *string    `json:"location"`
+	Wallet          int        `json:"wallet"`
+	AnonymizeDate   *time.Time `json:"anonymize_date"`
+	DataErasureDate *time.Time `json:"data_erasure_date"`
+	AlumnizedAt     *time.Time `json:"alumnized_at"`
+	Alumni          bool       `json:"alumni?"`
+	Active          bool       `json:"active?"`
+
+	Groups          []Group         `json:"groups,omitempty"`
+	CursusUsers     []CursusUser    `json:"cursus_users,omitempty"`
+	ProjectsUsers   []ProjectsUser  `json:"projects_users,omitempty"`
+	LanguagesUsers  []LanguageUser  `json:"languages_users,omitempty"`
+	Achievements    []Achievement   `json:"achievements,omitempty"`
+	Titles          []Title         `json:"titles,omitempty"`
+	TitlesUsers     []TitleUser     `json:"titles_users,omitempty"`
+	Partnerships    []Partnership   `json:"partnerships,omitempty"`
+	Patroned        []Patronage     `json:"patroned,omitempty"`
+	Patroning       []Patronage     `json:"patroning,omitempty"`
+	ExpertisesUsers []ExpertiseUser `json:"expertises_users,omitempty"`
+	Roles           []Role          `json:"roles,omitempty"`
+	Campus          []Campus        `json:"campus,omitempty"`
+	CampusUsers     []CampusUser    `json:"campus_users,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type user User
+
+	extra, err := unmarshalExtra(data, (*user)(u))
+	u.Extra = extra
+
+	return err
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+
+	return marshalExtra(user(u), u.Extra)
+}
+
+// PrimaryCampus returns the campus of the primary campus user, nil when the payload has none.
+func (u *User) PrimaryCampus() *Campus {
+	for _, cu := range u.CampusUsers {
+		if !cu.IsPrimary {
+			continue
+		}
+
+		for i := range u.Campus {
+			if u.Campus[i].ID == cu.CampusID {
+				return &u.Campus[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// UserSummary is the short form of a user embedded in the other resources.
+type UserSummary struct {
+	ID    int    `json:"id"`
+	Login string `json:"login"`
+	Url   string `json:"url"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (u *UserSummary) UnmarshalJSON(data []byte) error {
+	type userSummary UserSummary
+
+	extra, err := unmarshalExtra(data, (*userSummary)(u))
+	u.Extra = extra
+
+	return err
+}
+
+func (u UserSummary) MarshalJSON() ([]byte, error) {
+	type userSummary UserSummary
+
+	return marshalExtra(userSummary(u), u.Extra)
 }
@@ -0,0 +1,456 @@
+// Package validate checks the responses of the 42 API against the schemas of docs/openapi3.json,
+// to detect early the drift between the API and the models of the client.
+//
+// It is meant for debugging: every response body is buffered and decoded a second time.
+//
+//	v, err := validate.LoadFile("docs/openapi3.json")
+//	if err != nil {
+//		return err
+//	}
+//
+//	c, err := fortytwo.NewClient(ctx, id, secret, redirect, scopes,
+//		fortytwo.WithResponseHook(v.Hook(func(issue validate.Issue) {
+//			log.Println(issue)
+//		})),
+//	)
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+)
+
+// IssueKind defines the type for the kind of an Issue.
+type IssueKind string
+
+// IssueKind values.
+const (
+	// IssueUnknownField is a field of the response missing from the schema
+	IssueUnknownField IssueKind = "unknown_field"
+	// IssueMissingField is a required field of the schema missing from the response
+	IssueMissingField IssueKind = "missing_field"
+	// IssueTypeMismatch is a value whose type, format or enum differs from the schema
+	IssueTypeMismatch IssueKind = "type_mismatch"
+	// IssueUndocumentedType is a value whose schema has no type, e.g. a field always null in the examples
+	IssueUndocumentedType IssueKind = "undocumented_type"
+	// IssueUnknownEndpoint is a response to a request missing from the specification
+	IssueUnknownEndpoint IssueKind = "unknown_endpoint"
+)
+
+// String returns the string value for IssueKind.
+func (k IssueKind) String() string {
+	return string(k)
+}
+
+// Issue is a difference between a response and the specification.
+type Issue struct {
+	Kind   IssueKind
+	Method string
+	// Path is the path template of the operation, e.g. /users/{id}
+	Path string
+	// Field is the dotted path of the value in the body, [] standing for the items of a list
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (i Issue) String() string {
+	s := fmt.Sprintf("%s %s: %s", i.Method, i.Path, i.Kind)
+	if i.Field != "" {
+		s += " " + i.Field
+	}
+
+	var details []string
+	if i.Expected != "" {
+		details = append(details, "expected "+i.Expected)
+	}
+
+	if i.Actual != "" {
+		details = append(details, "got "+i.Actual)
+	}
+
+	if len(details) > 0 {
+		s += " (" + strings.Join(details, ", ") + ")"
+	}
+
+	return s
+}
+
+// Validator checks the responses against the schemas of a specification.
+type Validator struct {
+	basePath string
+	routes   []*route
+	schemas  map[string]*schema
+}
+
+type schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format"`
+	Nullable   bool               `json:"nullable"`
+	Properties map[string]*schema `json:"properties"`
+	Required   []string           `json:"required"`
+	Items      *schema            `json:"items"`
+	Enum       []interface{}      `json:"enum"`
+}
+
+type operation struct {
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema *schema `json:"schema"`
+		} `json:"content"`
+	} `json:"responses"`
+}
+
+type route struct {
+	template   string
+	segments   []string
+	literals   int
+	operations map[string]*operation
+}
+
+type document struct {
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// LoadFile reads the specification of a file.
+func LoadFile(path string) (*Validator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return New(f)
+}
+
+// New reads the specification of r.
+func New(r io.Reader) (*Validator, error) {
+	var doc document
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	v := &Validator{schemas: doc.Components.Schemas}
+	if len(doc.Servers) > 0 {
+		v.basePath = strings.TrimSuffix(doc.Servers[0].URL, "/")
+	}
+
+	for path, ops := range doc.Paths {
+		rt := &route{template: path, segments: split(path), operations: map[string]*operation{}}
+
+		for _, s := range rt.segments {
+			if !isParam(s) {
+				rt.literals++
+			}
+		}
+
+		for method, op := range ops {
+			rt.operations[strings.ToUpper(method)] = op
+		}
+
+		v.routes = append(v.routes, rt)
+	}
+
+	// The most specific template wins, e.g. /users/me over /users/{id}
+	sort.Slice(v.routes, func(i, j int) bool {
+		if v.routes[i].literals != v.routes[j].literals {
+			return v.routes[i].literals > v.routes[j].literals
+		}
+
+		return v.routes[i].template < v.routes[j].template
+	})
+
+	return v, nil
+}
+
+// Hook returns a response hook for fortytwo.WithResponseHook reporting the issues of every response.
+func (v *Validator) Hook(report func(Issue)) fortytwo.ResponseHook {
+	return func(req *http.Request, res *http.Response, body []byte) {
+		for _, issue := range v.Validate(req.Method, req.URL.Path, res.StatusCode, body) {
+			report(issue)
+		}
+	}
+}
+
+// Validate returns the issues of the body of a response to a request of the API, path is the URL
+// path, e.g. /v2/users/42. Every issue is reported once, whatever the number of list items having it.
+func (v *Validator) Validate(method, path string, status int, body []byte) []Issue {
+	method = strings.ToUpper(method)
+
+	rt := v.match(method, strings.TrimPrefix(path, v.basePath))
+	if rt == nil {
+		return []Issue{{Kind: IssueUnknownEndpoint, Method: method, Path: path}}
+	}
+
+	res, ok := rt.operations[method].Responses[strconv.Itoa(status)]
+	if !ok {
+		return nil
+	}
+
+	content, ok := res.Content["application/json"]
+	if !ok || content.Schema == nil {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&value); err != nil {
+		return []Issue{{Kind: IssueTypeMismatch, Method: method, Path: rt.template, Expected: "JSON", Actual: err.Error()}}
+	}
+
+	c := &checker{validator: v, method: method, path: rt.template, seen: map[Issue]bool{}}
+	c.check("", content.Schema, value)
+
+	return c.issues
+}
+
+func (v *Validator) match(method, path string) *route {
+	segments := split(path)
+
+	for _, rt := range v.routes {
+		if _, ok := rt.operations[method]; !ok || len(rt.segments) != len(segments) {
+			continue
+		}
+
+		matched := true
+
+		for i, s := range rt.segments {
+			if !isParam(s) && s != segments[i] {
+				matched = false
+
+				break
+			}
+		}
+
+		if matched {
+			return rt
+		}
+	}
+
+	return nil
+}
+
+type checker struct {
+	validator *Validator
+	method    string
+	path      string
+	seen      map[Issue]bool
+	issues    []Issue
+}
+
+func (c *checker) report(kind IssueKind, field, expected, actual string) {
+	issue := Issue{Kind: kind, Method: c.method, Path: c.path, Field: field, Expected: expected, Actual: actual}
+	if !c.seen[issue] {
+		c.seen[issue] = true
+		c.issues = append(c.issues, issue)
+	}
+}
+
+func (c *checker) check(field string, s *schema, value interface{}) {
+	for s != nil && s.Ref != "" {
+		s = c.validator.schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+	}
+
+	if s == nil {
+		return
+	}
+
+	if value == nil {
+		if !s.Nullable && s.Type != "" {
+			c.report(IssueTypeMismatch, field, s.Type, "null")
+		}
+
+		return
+	}
+
+	if s.Type == "" {
+		c.report(IssueUndocumentedType, field, "", jsonType(value))
+
+		return
+	}
+
+	if !matchesType(s, value) {
+		c.report(IssueTypeMismatch, field, expectedType(s), jsonType(value))
+
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		c.checkObject(field, s, value.(map[string]interface{}))
+	case "array":
+		for _, item := range value.([]interface{}) {
+			c.check(field+"[]", s.Items, item)
+		}
+	case "string":
+		if len(s.Enum) > 0 && !inEnum(s.Enum, value) {
+			c.report(IssueTypeMismatch, field, expectedType(s), fmt.Sprintf("%q", value))
+		}
+	}
+}
+
+func (c *checker) checkObject(field string, s *schema, obj map[string]interface{}) {
+	// Without properties the schema documents a free-form object
+	if len(s.Properties) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop, ok := s.Properties[name]
+		if !ok {
+			c.report(IssueUnknownField, join(field, name), "", jsonType(obj[name]))
+
+			continue
+		}
+
+		c.check(join(field, name), prop, obj[name])
+	}
+
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			c.report(IssueMissingField, join(field, name), expectedType(s.Properties[name]), "")
+		}
+	}
+}
+
+func matchesType(s *schema, value interface{}) bool {
+	switch s.Type {
+	case "object":
+		_, ok := value.(map[string]interface{})
+
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+
+		return ok
+	case "integer", "number":
+		// The types of the specification are inferred from the examples, a decimal value of a
+		// field whose examples are whole numbers is not a drift
+		_, ok := value.(float64)
+
+		return ok
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return false
+		}
+
+		switch s.Format {
+		case "date-time":
+			_, err := time.Parse(time.RFC3339, str)
+
+			return err == nil
+		case "date":
+			_, err := time.Parse("2006-01-02", str)
+
+			return err == nil
+		}
+
+		return true
+	}
+
+	return true
+}
+
+func expectedType(s *schema) string {
+	if s == nil {
+		return ""
+	}
+
+	t := s.Type
+	if t == "" && s.Ref != "" {
+		t = strings.TrimPrefix(s.Ref, "#/components/schemas/")
+	}
+
+	if s.Format != "" {
+		t += "(" + s.Format + ")"
+	}
+
+	if len(s.Enum) > 0 {
+		values := make([]string, len(s.Enum))
+		for i, e := range s.Enum {
+			values[i] = fmt.Sprint(e)
+		}
+
+		t += " enum(" + strings.Join(values, ", ") + ")"
+	}
+
+	return t
+}
+
+func jsonType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+
+		return "number"
+	case string:
+		return "string"
+	}
+
+	return fmt.Sprintf("%T", value)
+}
+
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if e == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func join(field, name string) string {
+	if field == "" {
+		return name
+	}
+
+	return field + "." + name
+}
+
+func split(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func isParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") || strings.HasPrefix(segment, ":")
+}
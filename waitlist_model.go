@@ -0,0 +1,40 @@
+package fortytwo
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type WaitlistID int
+
+func (pID WaitlistID) String() string {
+	return strconv.Itoa(int(pID))
+}
+
+// Waitlist holds the users waiting for a place in a full event or exam.
+type Waitlist struct {
+	ID               WaitlistID `json:"id"`
+	WaitlistableID   int        `json:"waitlistable_id"`
+	WaitlistableType string     `json:"waitlistable_type"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+
+	// Extra holds the fields of the response the model does not declare yet
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (w *Waitlist) UnmarshalJSON(data []byte) error {
+	type waitlist Waitlist
+
+	extra, err := unmarshalExtra(data, (*waitlist)(w))
+	w.Extra = extra
+
+	return err
+}
+
+func (w Waitlist) MarshalJSON() ([]byte, error) {
+	type waitlist Waitlist
+
+	return marshalExtra(waitlist(w), w.Extra)
+}
@@ -0,0 +1,188 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// State is what the watcher remembers about a resource between two polls.
+type State struct {
+	// Cursor is the updated_at of the most recent record seen
+	Cursor time.Time `json:"cursor"`
+	// Seen maps the ID of every record seen to the digest of its last known content
+	Seen map[int]string `json:"seen"`
+	// Polls counts the polls done since the last full scan
+	Polls int `json:"polls"`
+	// Scanned is true once a full scan has listed every record
+	Scanned bool `json:"scanned"`
+}
+
+// Store persists the state of the watched resources.
+//
+// Save is called once per poll, SaveCursor after every page of a poll so that an interrupted
+// poll resumes from its last page without rewriting the digests of every record.
+type Store interface {
+	// Load returns the state of a resource, nil if the resource was never watched
+	Load(resource string) (*State, error)
+	Save(resource string, state *State) error
+	// SaveCursor checkpoints the cursor of a resource, Load returns it until the next Save
+	SaveCursor(resource string, cursor time.Time) error
+}
+
+// MemoryStore keeps the states in memory, they are lost when the process exits.
+type MemoryStore struct {
+	mu      sync.Mutex
+	states  map[string]*State
+	cursors map[string]time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{states: map[string]*State{}, cursors: map[string]time.Time{}}
+}
+
+func (s *MemoryStore) Load(resource string) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cursor, ok := s.cursors[resource]
+	if !ok {
+		return s.states[resource], nil
+	}
+
+	return withCursor(s.states[resource], cursor), nil
+}
+
+func (s *MemoryStore) Save(resource string, state *State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[resource] = state
+	delete(s.cursors, resource)
+
+	return nil
+}
+
+func (s *MemoryStore) SaveCursor(resource string, cursor time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cursors[resource] = cursor
+
+	return nil
+}
+
+// FileStore keeps the state of every resource in a JSON file of a directory.
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore returns a FileStore writing to dir, the directory is created if needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &FileStore{Dir: dir}, nil
+}
+
+func (s *FileStore) Load(resource string) (*State, error) {
+	var state *State
+
+	data, err := os.ReadFile(s.path(resource))
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// A first poll interrupted after some pages left only its cursor
+	case err != nil:
+		return nil, err
+	default:
+		state = &State{}
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, err
+		}
+	}
+
+	cursor, err := os.ReadFile(s.cursorPath(resource))
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var t time.Time
+	if err := json.Unmarshal(cursor, &t); err != nil {
+		return nil, err
+	}
+
+	return withCursor(state, t), nil
+}
+
+// Save writes the state to a temporary file first, so that a crash never leaves a truncated state behind.
+func (s *FileStore) Save(resource string, state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(s.path(resource), data); err != nil {
+		return err
+	}
+
+	// The state holds the cursor from now on
+	if err := os.Remove(s.cursorPath(resource)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// SaveCursor writes the cursor to its own small file, next to the state.
+func (s *FileStore) SaveCursor(resource string, cursor time.Time) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(s.cursorPath(resource), data)
+}
+
+func (s *FileStore) path(resource string) string {
+	return filepath.Join(s.Dir, resource+".json")
+}
+
+func (s *FileStore) cursorPath(resource string) string {
+	return filepath.Join(s.Dir, resource+".cursor.json")
+}
+
+// writeFile writes a temporary file first, so that a crash never leaves a truncated file behind.
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// withCursor returns a copy of state with a checkpointed cursor, the state of a resource never
+// saved before has only the cursor.
+func withCursor(state *State, cursor time.Time) *State {
+	if state == nil {
+		return &State{Cursor: cursor}
+	}
+
+	copied := *state
+	if cursor.After(copied.Cursor) {
+		copied.Cursor = cursor
+	}
+
+	return &copied
+}
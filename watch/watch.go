@@ -0,0 +1,396 @@
+// Package watch polls list endpoints of the 42 API by updated_at and emits an event for every
+// record created, updated or deleted since the previous poll.
+//
+// Deletions can not be seen through updated_at, they are only detected by the periodic full
+// scans enabled with WithFullScanEvery.
+package watch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/naofel1/go-fortytwo"
+	"github.com/naofel1/go-fortytwo/source"
+)
+
+const defaultInterval = time.Minute
+
+// errOutOfBudget stops a poll early when the rate limit reserve is reached.
+var errOutOfBudget = errors.New("watch: rate limit reserve reached")
+
+// EventType defines the type for the change of a record.
+type EventType string
+
+// EventType values.
+const (
+	EventCreated EventType = "created"
+	EventUpdated EventType = "updated"
+	EventDeleted EventType = "deleted"
+)
+
+// String returns the string value for EventType.
+func (t EventType) String() string {
+	return string(t)
+}
+
+// Event is a change of a record of a watched resource.
+type Event struct {
+	Type      EventType
+	Resource  string
+	ID        int
+	UpdatedAt time.Time
+	// Data is the current content of the record, nil for a deleted record
+	Data json.RawMessage
+}
+
+// Decode unmarshals the content of the record into v, e.g. a *fortytwo.CursusUser.
+func (e *Event) Decode(v interface{}) error {
+	if e.Data == nil {
+		return fmt.Errorf("watch: %s %d has no content", e.Resource, e.ID)
+	}
+
+	return json.Unmarshal(e.Data, v)
+}
+
+// RateLimiter reports the remaining request budget, *fortytwo.Client implements it.
+type RateLimiter interface {
+	RateLimit() *fortytwo.RateLimit
+}
+
+// handlerError wraps the errors returned by the event handler, they always stop the watcher.
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string {
+	return e.err.Error()
+}
+
+func (e *handlerError) Unwrap() error {
+	return e.err
+}
+
+// Option to configure the watcher
+type Option func(*Watcher)
+
+// Watcher polls sources and emits their changes.
+type Watcher struct {
+	sources       []source.Source
+	store         Store
+	interval      time.Duration
+	pageSize      int
+	fullScanEvery int
+	startAt       time.Time
+	limiter       RateLimiter
+	reserve       int
+	onError       func(error)
+}
+
+// New returns a watcher polling the given sources. Unless WithStartAt is used,
+// only the changes made after the first poll of a resource are emitted.
+func New(sources []source.Source, opts ...Option) *Watcher {
+	w := &Watcher{
+		sources:  sources,
+		store:    NewMemoryStore(),
+		interval: defaultInterval,
+		pageSize: source.DefaultPageSize,
+		startAt:  time.Now(),
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// WithInterval overrides the delay between two polls
+func WithInterval(interval time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithStore persists the cursors and the seen records, e.g. in a FileStore to survive restarts
+func WithStore(store Store) Option {
+	return func(w *Watcher) {
+		w.store = store
+	}
+}
+
+// WithPageSize overrides the number of records fetched per request
+func WithPageSize(size int) Option {
+	return func(w *Watcher) {
+		w.pageSize = size
+	}
+}
+
+// WithStartAt emits the changes made since t for the resources that were never polled
+func WithStartAt(t time.Time) Option {
+	return func(w *Watcher) {
+		w.startAt = t
+	}
+}
+
+// WithFullScanEvery crawls every record once every n polls to detect the deleted records
+func WithFullScanEvery(n int) Option {
+	return func(w *Watcher) {
+		w.fullScanEvery = n
+	}
+}
+
+// WithRateLimiter postpones the rest of a poll to the next one when less than reserve requests remain
+// in the hourly budget, and waits for the next second when the secondly budget is exhausted
+func WithRateLimiter(limiter RateLimiter, reserve int) Option {
+	return func(w *Watcher) {
+		w.limiter = limiter
+		w.reserve = reserve
+	}
+}
+
+// WithErrorHandler keeps the watcher running when a poll fails, the error is reported to fn instead
+func WithErrorHandler(fn func(error)) Option {
+	return func(w *Watcher) {
+		w.onError = fn
+	}
+}
+
+// Run polls the sources every interval and calls fn for every change until ctx is done.
+// It returns the first error of fn or, without error handler, the first poll error.
+func (w *Watcher) Run(ctx context.Context, fn func(Event) error) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Poll(ctx, fn); err != nil {
+			var hErr *handlerError
+			if errors.As(err, &hErr) || w.onError == nil || ctx.Err() != nil {
+				return err
+			}
+
+			w.onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Events runs the watcher in the background and delivers the changes on the returned channel.
+// The channel is closed when the watcher stops, the reason is then sent on the error channel.
+func (w *Watcher) Events(ctx context.Context) (<-chan Event, <-chan error) {
+	events := make(chan Event)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+
+		errs <- w.Run(ctx, func(e Event) error {
+			select {
+			case events <- e:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	return events, errs
+}
+
+// Poll checks every source once and calls fn for every change.
+func (w *Watcher) Poll(ctx context.Context, fn func(Event) error) error {
+	for _, src := range w.sources {
+		if err := w.poll(ctx, src, fn); err != nil {
+			return fmt.Errorf("watch: poll %s: %w", src.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *Watcher) poll(ctx context.Context, src source.Source, fn func(Event) error) error {
+	state, err := w.store.Load(src.Name)
+	if err != nil {
+		return err
+	}
+
+	if state == nil {
+		state = &State{Cursor: w.startAt}
+	}
+
+	if state.Seen == nil {
+		state.Seen = map[int]string{}
+	}
+
+	fullScan := !src.Incremental || (w.fullScanEvery > 0 && state.Polls >= w.fullScanEvery-1)
+
+	if fullScan {
+		err = w.scan(ctx, src, state, fn)
+	} else {
+		err = w.walk(ctx, src, state, fn)
+	}
+
+	if errors.Is(err, errOutOfBudget) {
+		return w.store.Save(src.Name, state)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if fullScan {
+		state.Polls = 0
+	} else {
+		state.Polls++
+	}
+
+	return w.store.Save(src.Name, state)
+}
+
+// walk emits the changes of the records updated since the cursor.
+func (w *Watcher) walk(ctx context.Context, src source.Source, state *State, fn func(Event) error) error {
+	since := state.Cursor
+
+	return source.WalkUpdated(ctx, src, source.Position{Cursor: since}, w.pageSize, func(records []source.Record, next source.Position) error {
+		for _, r := range records {
+			if err := w.emit(src, state, since, r, fn); err != nil {
+				return err
+			}
+		}
+
+		state.Cursor = next.Cursor
+
+		if err := w.store.SaveCursor(src.Name, next.Cursor); err != nil {
+			return err
+		}
+
+		return w.waitBudget(ctx)
+	})
+}
+
+// scan crawls every record, emits their changes and a deletion for the records that disappeared.
+func (w *Watcher) scan(ctx context.Context, src source.Source, state *State, fn func(Event) error) error {
+	since := state.Cursor
+	present := make(map[int]bool, len(state.Seen))
+
+	err := source.Walk(ctx, src, &fortytwo.Params{Sort: []string{"id"}}, w.pageSize, 1, func(_ int, records []source.Record) error {
+		for _, r := range records {
+			present[r.ID] = true
+
+			if err := w.emit(src, state, since, r, fn); err != nil {
+				return err
+			}
+
+			if r.UpdatedAt.After(state.Cursor) {
+				state.Cursor = r.UpdatedAt
+			}
+		}
+
+		return w.waitBudget(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	for id := range state.Seen {
+		if present[id] {
+			continue
+		}
+
+		delete(state.Seen, id)
+
+		if state.Scanned {
+			if err := fn(Event{Type: EventDeleted, Resource: src.Name, ID: id}); err != nil {
+				return &handlerError{err: err}
+			}
+		}
+	}
+
+	state.Scanned = true
+
+	return nil
+}
+
+// emit compares a record with its last known content and calls fn if it changed.
+// Records last updated before the watcher started are only remembered.
+func (w *Watcher) emit(src source.Source, state *State, since time.Time, r source.Record, fn func(Event) error) error {
+	sum := sha256.Sum256(r.Data)
+	digest := hex.EncodeToString(sum[:16])
+
+	old, known := state.Seen[r.ID]
+	if known && old == digest {
+		return nil
+	}
+
+	state.Seen[r.ID] = digest
+
+	var typ EventType
+
+	switch {
+	case known:
+		typ = EventUpdated
+	case r.UpdatedAt.IsZero():
+		// Without updated_at, a new record is only a creation once a first full scan is known
+		if !state.Scanned {
+			return nil
+		}
+
+		typ = EventCreated
+	case r.UpdatedAt.Before(since):
+		return nil
+	case r.CreatedAt.IsZero() || !r.CreatedAt.Before(since):
+		typ = EventCreated
+	default:
+		typ = EventUpdated
+	}
+
+	e := Event{
+		Type:      typ,
+		Resource:  src.Name,
+		ID:        r.ID,
+		UpdatedAt: r.UpdatedAt,
+		Data:      r.Data,
+	}
+
+	if err := fn(e); err != nil {
+		return &handlerError{err: err}
+	}
+
+	return nil
+}
+
+// waitBudget returns errOutOfBudget when the hourly reserve is reached and waits
+// for the next second when the secondly budget is exhausted.
+func (w *Watcher) waitBudget(ctx context.Context) error {
+	if w.limiter == nil {
+		return nil
+	}
+
+	rl := w.limiter.RateLimit()
+	if rl == nil {
+		return nil
+	}
+
+	if rl.HourlyRemaining <= w.reserve {
+		return errOutOfBudget
+	}
+
+	if rl.SecondlyLimit > 0 && rl.SecondlyRemaining == 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+
+	return nil
+}